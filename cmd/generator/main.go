@@ -5,8 +5,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/content"
 	"github.com/mazrean/go-proposal-review-meeting/internal/site"
@@ -19,11 +24,60 @@ func main() {
 	}
 }
 
+// preflightTimeout bounds the site URL preflight request.
+const preflightTimeout = 10 * time.Second
+
 func run() error {
 	// Parse command-line flags
 	contentDir := flag.String("content", "content", "Directory containing content files")
+	archiveDir := flag.String("archive-dir", "", "Directory of weeks archived by cmd/prune to include as well (e.g. content/archive)")
 	distDir := flag.String("dist", "dist", "Output directory for generated files")
 	siteURL := flag.String("site-url", "https://example.com", "Site URL for RSS feed generation")
+	changelog := flag.Bool("changelog", false, "Generate changelog.txt listing all status transitions")
+	dataExport := flag.Bool("data-json", false, "Generate data.json exporting all weeks, proposals, summaries and links as JSON")
+	provisional := flag.Bool("provisional-style", false, "De-emphasize badges of non-final statuses (all but accepted/declined/retracted/removed)")
+	monthly := flag.Bool("monthly", false, "Generate monthly rollup pages (YYYY/MM/index.html)")
+	categoryPages := flag.Bool("category-pages", false, "Generate per-package category pages (category/<name>/index.html) derived from proposal titles")
+	weeklyLayout := flag.String("weekly-layout", "cards", "Weekly index layout: cards or table (accessible data table)")
+	yearReview := flag.Bool("year-in-review", false, "Generate year in review pages (YYYY/review.html)")
+	useUpdatedAt := flag.Bool("use-updated-at", false, "Use updated_at (last summary/link edit) for sitemap lastmod and feed updated dates")
+	statusContext := flag.Bool("status-context", false, "Show when the previous status was set on proposal pages")
+	maxInFlight := flag.Int("max-in-flight", 1, "Maximum number of weeks rendered concurrently")
+	templateDir := flag.String("template-dir", "", "Directory with home.html, weekly.html or proposal.html html/template overrides")
+	incremental := flag.Bool("incremental", false, "Skip rewriting weekly, proposal and aggregate pages whose content is unchanged")
+	backLinkAnchors := flag.Bool("back-link-anchors", false, "Link proposal pages back to their position on the weekly index")
+	maxTitleLength := flag.Int("max-title-length", 0, "Truncate proposal titles in headings and feeds to this many characters (0 disables)")
+	stripTitlePrefix := flag.Bool("strip-title-prefix", false, "Omit the \"proposal:\" prefix from proposal titles on pages and in feeds")
+	recentDecisionDays := flag.Int("recent-decision-days", 0, "Highlight proposals reaching a final status within this many days (0 = disabled)")
+	noIndexAggregates := flag.Bool("noindex-aggregates", false, "Mark aggregate pages (monthly, category, year in review and stats pages) noindex and omit them from sitemap.xml")
+	hashedAssets := flag.Bool("hashed-assets", false, "Reference content-hashed copies of styles.css and components.js (build them into -dist first)")
+	minify := flag.Bool("minify", false, "Strip comments and collapse insignificant whitespace in generated HTML")
+	noIndex := flag.Bool("noindex", false, "Ask search engines not to index the site (disallow-all robots.txt and noindex on every page), e.g. for preview deployments")
+	maxFeedItems := flag.Int("max-feed-items", 20, "Maximum number of weeks included in the RSS and JSON feeds")
+	maxLinks := flag.Int("max-links", 0, "Maximum related links shown per proposal before collapsing the rest (0 = no limit)")
+	opml := flag.Bool("opml", false, "Generate feeds.opml listing all generated feeds")
+	siteTitle := flag.String("site-title", "Go Proposal Weekly Digest", "Site title available to title templates as {{.SiteTitle}}")
+	homeTitle := flag.String("home-title", "", "text/template for the home page title (default: built-in title)")
+	weeklyTitle := flag.String("weekly-title", "", "text/template for weekly page titles (default: built-in title)")
+	proposalTitle := flag.String("proposal-title", "", "text/template for proposal page titles (default: built-in title)")
+	feedItemTitle := flag.String("feed-item-title", "", "text/template for RSS item titles; {{.ProposalCount}} and {{.StatusBreakdown}} describe the week (default: built-in title)")
+	preflight := flag.Bool("preflight", false, "Send a HEAD request to -site-url before generating and warn if it is unreachable")
+	strict := flag.Bool("strict", false, "Fail instead of warning when the -preflight check fails")
+	humansTxt := flag.Bool("humans-txt", false, "Generate humans.txt crediting maintainers and the data source")
+	var maintainers []string
+	flag.Func("maintainer", "Maintainer credited in humans.txt (repeatable, e.g. -maintainer \"Jane Doe <https://github.com/janedoe>\")", func(v string) error {
+		maintainers = append(maintainers, v)
+		return nil
+	})
+	var alternates []site.Option
+	flag.Func("alternate", "Language version of the site for hreflang links as lang=baseURL (repeatable, e.g. -alternate ja=https://example.com -alternate en=https://example.com/en)", func(v string) error {
+		lang, baseURL, ok := strings.Cut(v, "=")
+		if !ok || lang == "" || baseURL == "" {
+			return fmt.Errorf("expected lang=baseURL, got %q", v)
+		}
+		alternates = append(alternates, site.WithAlternateLanguage(lang, baseURL))
+		return nil
+	})
 	flag.Parse()
 
 	// Validate flags
@@ -36,6 +90,10 @@ func run() error {
 	if *siteURL == "" {
 		return fmt.Errorf("site URL cannot be empty")
 	}
+	layout := site.WeeklyLayout(*weeklyLayout)
+	if layout != site.WeeklyLayoutCards && layout != site.WeeklyLayoutTable {
+		return fmt.Errorf("invalid -weekly-layout %q: must be cards or table", *weeklyLayout)
+	}
 	parsedURL, err := url.Parse(*siteURL)
 	if err != nil {
 		return fmt.Errorf("invalid site URL: %w", err)
@@ -47,6 +105,13 @@ func run() error {
 		return fmt.Errorf("site URL must include a host: %s", *siteURL)
 	}
 
+	if *preflight {
+		client := &http.Client{Timeout: preflightTimeout}
+		if err := preflightSiteURL(context.Background(), client, *siteURL, *strict, os.Stderr); err != nil {
+			return err
+		}
+	}
+
 	fmt.Println("Go Proposal Weekly Digest Generator")
 	fmt.Printf("Content directory: %s\n", *contentDir)
 	fmt.Printf("Output directory: %s\n", *distDir)
@@ -73,13 +138,54 @@ func run() error {
 		return fmt.Errorf("failed to list weekly contents: %w", err)
 	}
 
+	if *archiveDir != "" {
+		archived, err := content.NewManager(content.WithBaseDir(*archiveDir)).ListAllWeeks()
+		if err != nil {
+			return fmt.Errorf("failed to list archived weekly contents: %w", err)
+		}
+		fmt.Printf("Found %d archived weeks in %s\n", len(archived), *archiveDir)
+		weeks = mergeArchivedWeeks(weeks, archived)
+	}
+
 	fmt.Printf("Found %d weeks of content\n", len(weeks))
 
 	// Create site generator
-	generator := site.NewGenerator(
+	opts := []site.Option{
 		site.WithDistDir(*distDir),
 		site.WithGeneratorSiteURL(*siteURL),
-	)
+		site.WithChangelog(*changelog),
+		site.WithDataExport(*dataExport),
+		site.WithProvisionalStatuses(*provisional),
+		site.WithMonthlyPages(*monthly),
+		site.WithCategoryPages(*categoryPages),
+		site.WithUpdatedAtDates(*useUpdatedAt),
+		site.WithStatusContext(*statusContext),
+		site.WithMaxInFlight(*maxInFlight),
+		site.WithIncremental(*incremental),
+		site.WithTemplateDir(*templateDir),
+		site.WithBackLinkAnchors(*backLinkAnchors),
+		site.WithMaxTitleLength(*maxTitleLength),
+		site.WithStripTitlePrefix(*stripTitlePrefix),
+		site.WithOPML(*opml),
+		site.WithYearInReview(*yearReview),
+		site.WithWeeklyLayout(layout),
+		site.WithMaxLinks(*maxLinks),
+		site.WithMaxFeedItems(*maxFeedItems),
+		site.WithNoIndexAggregates(*noIndexAggregates),
+		site.WithNoIndex(*noIndex),
+		site.WithMinify(*minify),
+		site.WithHashedAssets(*hashedAssets),
+		site.WithRecentDecisionWindow(time.Duration(*recentDecisionDays) * 24 * time.Hour),
+		site.WithGeneratorSiteTitle(*siteTitle),
+		site.WithHomeTitleTemplate(*homeTitle),
+		site.WithWeeklyTitleTemplate(*weeklyTitle),
+		site.WithProposalTitleTemplate(*proposalTitle),
+		site.WithGeneratorFeedItemTitleTemplate(*feedItemTitle),
+	}
+	if *humansTxt {
+		opts = append(opts, site.WithHumansTxt(maintainers...))
+	}
+	generator := site.NewGenerator(append(opts, alternates...)...)
 
 	// Generate the site
 	ctx := context.Background()
@@ -88,7 +194,85 @@ func run() error {
 	}
 
 	fmt.Println("Site generation completed successfully!")
-	fmt.Println("  - HTML pages generated")
+	stats := generator.Stats()
+	if *incremental {
+		fmt.Printf("  - HTML pages generated (%d written, %d unchanged)\n", stats.Written, stats.Skipped)
+	} else {
+		fmt.Println("  - HTML pages generated")
+	}
 	fmt.Println("  - RSS feed generated (feed.xml)")
+	fmt.Println("  - Status RSS feeds generated (feed-accepted.xml, feed-declined.xml)")
+	fmt.Println("  - JSON Feed generated (feed.json)")
+	fmt.Println("  - Atom feed generated (atom.xml)")
+	fmt.Println("  - Sitemap generated (sitemap.xml)")
+	fmt.Println("  - robots.txt generated")
+	if *changelog {
+		fmt.Println("  - Changelog generated (changelog.txt)")
+	}
+	if *dataExport {
+		fmt.Println("  - Data export generated (data.json)")
+	}
+	if *opml {
+		fmt.Println("  - OPML generated (feeds.opml)")
+	}
+	if *humansTxt {
+		fmt.Println("  - humans.txt generated")
+	}
+	return nil
+}
+
+// mergeArchivedWeeks adds the archived weeks to weeks, newest first.
+// A week present in both keeps the copy from the content directory.
+func mergeArchivedWeeks(weeks, archived []*content.WeeklyContent) []*content.WeeklyContent {
+	seen := make(map[[2]int]bool, len(weeks))
+	for _, w := range weeks {
+		seen[[2]int{w.Year, w.Week}] = true
+	}
+	for _, w := range archived {
+		if !seen[[2]int{w.Year, w.Week}] {
+			weeks = append(weeks, w)
+		}
+	}
+	sort.Slice(weeks, func(i, j int) bool {
+		if weeks[i].Year != weeks[j].Year {
+			return weeks[i].Year > weeks[j].Year
+		}
+		return weeks[i].Week > weeks[j].Week
+	})
+	return weeks
+}
+
+// preflightSiteURL sends a HEAD request to siteURL to catch typos before
+// absolute links are published. Network errors and error statuses are
+// reported to warn and ignored, unless strict is true.
+func preflightSiteURL(ctx context.Context, client *http.Client, siteURL string, strict bool, warn io.Writer) error {
+	err := checkSiteURL(ctx, client, siteURL)
+	if err == nil {
+		return nil
+	}
+	if strict {
+		return fmt.Errorf("site URL preflight failed: %w", err)
+	}
+	fmt.Fprintf(warn, "warning: site URL preflight failed: %v\n", err)
+	return nil
+}
+
+// checkSiteURL reports whether siteURL answers a HEAD request without an
+// error status. Redirects are followed.
+func checkSiteURL(ctx context.Context, client *http.Client, siteURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, siteURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", siteURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("%s returned status %d", siteURL, resp.StatusCode)
+	}
 	return nil
 }
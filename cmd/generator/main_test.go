@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+func TestPreflightSiteURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("method = %s, want HEAD", r.Method)
+		}
+		if r.URL.Path == "/missing/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	// The .invalid TLD is reserved and never resolves.
+	const unreachable = "http://nonexistent.invalid"
+
+	tests := []struct {
+		name     string
+		siteURL  string
+		strict   bool
+		wantErr  bool
+		wantWarn bool
+	}{
+		{name: "reachable site", siteURL: server.URL},
+		{name: "reachable site strict", siteURL: server.URL, strict: true},
+		{name: "unreachable host only warns", siteURL: unreachable, wantWarn: true},
+		{name: "unreachable host strict fails", siteURL: unreachable, strict: true, wantErr: true},
+		{name: "error status only warns", siteURL: server.URL + "/missing/", wantWarn: true},
+		{name: "error status strict fails", siteURL: server.URL + "/missing/", strict: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var warn bytes.Buffer
+			client := &http.Client{Timeout: 5 * time.Second}
+			err := preflightSiteURL(context.Background(), client, tt.siteURL, tt.strict, &warn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("preflightSiteURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := strings.Contains(warn.String(), "warning: site URL preflight failed"); got != tt.wantWarn {
+				t.Errorf("warning written = %v, want %v (output: %q)", got, tt.wantWarn, warn.String())
+			}
+		})
+	}
+}
+
+func TestMergeArchivedWeeks(t *testing.T) {
+	t.Parallel()
+
+	current := &content.WeeklyContent{Year: 2026, Week: 5}
+	weeks := []*content.WeeklyContent{current, {Year: 2026, Week: 2}}
+	archived := []*content.WeeklyContent{{Year: 2026, Week: 5}, {Year: 2025, Week: 50}, {Year: 2026, Week: 1}}
+
+	got := mergeArchivedWeeks(weeks, archived)
+	want := [][2]int{{2026, 5}, {2026, 2}, {2026, 1}, {2025, 50}}
+	if len(got) != len(want) {
+		t.Fatalf("got %d weeks, want %d", len(got), len(want))
+	}
+	for i, w := range got {
+		if [2]int{w.Year, w.Week} != want[i] {
+			t.Errorf("week[%d] = %d-W%02d, want %d-W%02d", i, w.Year, w.Week, want[i][0], want[i][1])
+		}
+	}
+	if got[0] != current {
+		t.Error("a week in both trees should keep the content directory copy")
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"log/slog"
 	"os"
+	"slices"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/content"
 	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
@@ -18,6 +22,19 @@ type ChangesFile struct {
 	Changes []parser.ProposalChange `json:"changes"`
 }
 
+// CoverageReport lists, per week, the proposals that received an AI summary
+// and those that fell back to generated text.
+type CoverageReport struct {
+	Weeks []WeekCoverage `json:"weeks"`
+}
+
+// WeekCoverage is the summary coverage of one week.
+type WeekCoverage struct {
+	Week       string `json:"week"`
+	Summarized []int  `json:"summarized"`
+	Fallback   []int  `json:"fallback"`
+}
+
 func main() {
 	if err := run(); err != nil {
 		fmt.Fprintf(os.Stderr, "error: %v\n", err)
@@ -29,8 +46,31 @@ func run() error {
 	changesPath := flag.String("changes", "changes.json", "Path to changes.json")
 	contentDir := flag.String("content", "content", "Path to content directory")
 	summariesDir := flag.String("summaries", "summaries", "Path to summaries directory")
+	checkIssues := flag.Bool("check-summary-issues", false, "Warn when a summary mostly references a different issue than its filename")
+	readConcurrency := flag.Int("read-concurrency", 1, "Maximum number of summary files read concurrently")
+	summaryLang := flag.String("summary-lang", "", "Preferred summary language for files like 12345.en.md (default: -default-summary-lang)")
+	defaultSummaryLang := flag.String("default-summary-lang", content.DefaultSummaryLanguage, "Language of summary files without a language suffix, used as fallback")
+	lineEnding := flag.String("line-ending", "lf", "Line ending of written content files (lf or crlf)")
+	keepTransitions := flag.Bool("keep-transitions", false, "Keep every distinct same-week status transition of a proposal as history (the latest stays current)")
+	reconstruct := flag.Bool("reconstruct", false, "Rebuild changes.json from the content directory instead of integrating")
+	verbose := flag.Bool("verbose", false, "Log integrated summaries, applied fallbacks, merges and written files to stderr")
+	coverageJSON := flag.String("coverage-json", "", "Also write the summary coverage report as JSON to this path")
 	flag.Parse()
 
+	var le content.LineEnding
+	switch *lineEnding {
+	case "lf":
+		le = content.LineEndingLF
+	case "crlf":
+		le = content.LineEndingCRLF
+	default:
+		return fmt.Errorf("invalid -line-ending %q: must be lf or crlf", *lineEnding)
+	}
+
+	if *reconstruct {
+		return reconstructChanges(*contentDir, *changesPath)
+	}
+
 	// Read changes.json
 	// Note: PreviousStatus is already set by the parse command based on
 	// the proposal's status at the time of the immediately preceding comment.
@@ -52,11 +92,25 @@ func run() error {
 		return nil
 	}
 
+	// Warnings are always logged; -verbose adds the progress logs
+	logLevel := slog.LevelWarn
+	if *verbose {
+		logLevel = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
+
 	// Create content manager
-	mgr := content.NewManager(
+	opts := []content.Option{
 		content.WithBaseDir(*contentDir),
 		content.WithSummariesDir(*summariesDir),
-	)
+		content.WithReadConcurrency(*readConcurrency),
+		content.WithSummaryLanguage(*summaryLang),
+		content.WithDefaultSummaryLanguage(*defaultSummaryLang),
+		content.WithIssueMismatchCheck(*checkIssues),
+		content.WithLogger(logger),
+	}
+	opts = append(opts, content.WithLineEnding(le))
+	mgr := content.NewManager(opts...)
 
 	// Group changes by week
 	weeklyChanges := groupByWeek(changesFile.Changes)
@@ -70,35 +124,39 @@ func run() error {
 	sort.Strings(weekKeys)
 
 	// Read summaries
-	summaries, err := mgr.ReadSummaries()
+	summaries, err := mgr.ReadLocalizedSummaries()
 	if err != nil {
 		return fmt.Errorf("failed to read summaries: %w", err)
 	}
 	fmt.Printf("Loaded %d summaries\n", len(summaries))
 
 	// Process each week in chronological order
+	var report CoverageReport
 	for _, weekKey := range weekKeys {
 		changes := weeklyChanges[weekKey]
 		fmt.Printf("Processing week %s with %d changes\n", weekKey, len(changes))
 
-		// Deduplicate: keep the latest change for each issue within the week
-		deduped := deduplicateByIssue(changes)
-		if len(deduped) != len(changes) {
-			fmt.Printf("  Deduplicated from %d to %d changes\n", len(changes), len(deduped))
+		// Deduplicate and prepare content
+		weeklyContent := prepareWeek(mgr, changes, *keepTransitions)
+		if len(weeklyContent.Proposals) != len(changes) {
+			fmt.Printf("  Deduplicated from %d to %d changes\n", len(changes), len(weeklyContent.Proposals))
 		}
 
-		// Prepare content
-		weeklyContent := mgr.PrepareContent(deduped)
-
 		// Integrate summaries
-		if err := mgr.IntegrateSummaries(weeklyContent, summaries); err != nil {
+		if err := mgr.IntegrateLocalizedSummaries(weeklyContent, summaries); err != nil {
 			return fmt.Errorf("failed to integrate summaries: %w", err)
 		}
 
 		// Apply fallback for missing summaries
-		if err := mgr.ApplyFallback(weeklyContent); err != nil {
+		fallback, err := mgr.ApplyFallback(weeklyContent)
+		if err != nil {
 			return fmt.Errorf("failed to apply fallback: %w", err)
 		}
+		coverage := weekCoverage(weekKey, weeklyContent, fallback)
+		report.Weeks = append(report.Weeks, coverage)
+		fmt.Printf("  Summaries: %d %s, fallback: %d %s\n",
+			len(coverage.Summarized), formatIssues(coverage.Summarized),
+			len(coverage.Fallback), formatIssues(coverage.Fallback))
 
 		// Write content with merge
 		if err := mgr.WriteContentWithMerge(weeklyContent); err != nil {
@@ -109,10 +167,86 @@ func run() error {
 			len(weeklyContent.Proposals), weeklyContent.Year, weeklyContent.Week)
 	}
 
+	if *coverageJSON != "" {
+		if err := writeCoverageJSON(*coverageJSON, report); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote summary coverage report to %s\n", *coverageJSON)
+	}
+
 	fmt.Println("Content integration completed successfully!")
 	return nil
 }
 
+// weekCoverage splits the proposals of weeklyContent into those with an AI
+// summary and those in fallback, the issues ApplyFallback touched.
+func weekCoverage(week string, weeklyContent *content.WeeklyContent, fallback []int) WeekCoverage {
+	coverage := WeekCoverage{Week: week, Summarized: []int{}, Fallback: []int{}}
+	for _, p := range weeklyContent.Proposals {
+		if slices.Contains(fallback, p.IssueNumber) {
+			coverage.Fallback = append(coverage.Fallback, p.IssueNumber)
+		} else {
+			coverage.Summarized = append(coverage.Summarized, p.IssueNumber)
+		}
+	}
+	return coverage
+}
+
+// formatIssues formats issue numbers as "(#1, #2)", or "" if there are none.
+func formatIssues(issues []int) string {
+	if len(issues) == 0 {
+		return ""
+	}
+	parts := make([]string, len(issues))
+	for i, issue := range issues {
+		parts[i] = fmt.Sprintf("#%d", issue)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// writeCoverageJSON writes report to path as indented JSON.
+func writeCoverageJSON(path string, report CoverageReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal coverage report: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// reconstructChanges rebuilds changes.json at changesPath from the content
+// directory. It refuses to overwrite an existing file.
+func reconstructChanges(contentDir, changesPath string) error {
+	if _, err := os.Stat(changesPath); err == nil {
+		return fmt.Errorf("refusing to overwrite existing %s", changesPath)
+	}
+
+	mgr := content.NewManager(content.WithBaseDir(contentDir))
+	changes, err := mgr.ReconstructChanges()
+	if err != nil {
+		return fmt.Errorf("failed to reconstruct changes: %w", err)
+	}
+
+	changesFile := ChangesFile{Changes: changes}
+	if len(changes) > 0 {
+		year, week := changes[len(changes)-1].ChangedAt.ISOWeek()
+		changesFile.Week = fmt.Sprintf("%d-W%02d", year, week)
+	}
+
+	data, err := json.MarshalIndent(changesFile, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal changes: %w", err)
+	}
+	if err := os.WriteFile(changesPath, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", changesPath, err)
+	}
+
+	fmt.Printf("Reconstructed %d changes into %s\n", len(changes), changesPath)
+	return nil
+}
+
 // groupByWeek groups proposal changes by their ISO week
 func groupByWeek(changes []parser.ProposalChange) map[string][]parser.ProposalChange {
 	result := make(map[string][]parser.ProposalChange)
@@ -126,16 +260,25 @@ func groupByWeek(changes []parser.ProposalChange) map[string][]parser.ProposalCh
 	return result
 }
 
-// deduplicateByIssue keeps the latest change for each issue number
+// prepareWeek deduplicates a week's changes to the latest change for each
+// issue and prepares its content. If keepTransitions is true, every distinct
+// (issue, status) transition of the week is kept as the proposal's history.
+func prepareWeek(mgr *content.Manager, changes []parser.ProposalChange, keepTransitions bool) *content.WeeklyContent {
+	weeklyContent := mgr.PrepareContent(deduplicateByIssue(changes))
+	if keepTransitions {
+		mgr.AttachTransitions(weeklyContent, content.CollectTransitions(changes))
+	}
+	return weeklyContent
+}
+
+// deduplicateByIssue keeps the latest change for each issue number.
+// Changes with the same ChangedAt are ordered by preferChange, so the winner
+// does not depend on input order. The result is sorted by issue number.
 func deduplicateByIssue(changes []parser.ProposalChange) []parser.ProposalChange {
 	issueMap := make(map[int]parser.ProposalChange)
 
 	for _, change := range changes {
-		if existing, ok := issueMap[change.IssueNumber]; ok {
-			if change.ChangedAt.After(existing.ChangedAt) {
-				issueMap[change.IssueNumber] = change
-			}
-		} else {
+		if existing, ok := issueMap[change.IssueNumber]; !ok || preferChange(change, existing) {
 			issueMap[change.IssueNumber] = change
 		}
 	}
@@ -144,7 +287,42 @@ func deduplicateByIssue(changes []parser.ProposalChange) []parser.ProposalChange
 	for _, change := range issueMap {
 		result = append(result, change)
 	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].IssueNumber < result[j].IssueNumber
+	})
 
 	return result
 }
 
+// preferChange reports whether a should replace b as the latest change of an
+// issue. The later ChangedAt wins; ties go to the later minutes comment
+// (higher comment ID in the URL), then to the greater comment URL, then to
+// the greater current status.
+func preferChange(a, b parser.ProposalChange) bool {
+	if !a.ChangedAt.Equal(b.ChangedAt) {
+		return a.ChangedAt.After(b.ChangedAt)
+	}
+	idA, okA := commentID(a.CommentURL)
+	idB, okB := commentID(b.CommentURL)
+	if okA && okB && idA != idB {
+		return idA > idB
+	}
+	if a.CommentURL != b.CommentURL {
+		return a.CommentURL > b.CommentURL
+	}
+	return a.CurrentStatus > b.CurrentStatus
+}
+
+// commentID extracts the comment ID from a URL ending in
+// "#issuecomment-<id>".
+func commentID(commentURL string) (int64, bool) {
+	_, idStr, ok := strings.Cut(commentURL, "#issuecomment-")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestPrepareWeek_KeepTransitions(t *testing.T) {
+	t.Parallel()
+
+	likelyAcceptAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	acceptedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	changes := []parser.ProposalChange{
+		{
+			IssueNumber:    12345,
+			Title:          "proposal: two transitions",
+			PreviousStatus: parser.StatusActive,
+			CurrentStatus:  parser.StatusLikelyAccept,
+			ChangedAt:      likelyAcceptAt,
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+		},
+		{
+			IssueNumber:    12345,
+			Title:          "proposal: two transitions",
+			PreviousStatus: parser.StatusLikelyAccept,
+			CurrentStatus:  parser.StatusAccepted,
+			ChangedAt:      acceptedAt,
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-2",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		wantTransitions []parser.Status
+		keepTransitions bool
+	}{
+		{
+			name:            "transitions kept",
+			keepTransitions: true,
+			wantTransitions: []parser.Status{parser.StatusLikelyAccept, parser.StatusAccepted},
+		},
+		{
+			name:            "latest only by default",
+			keepTransitions: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := content.NewManager(content.WithBaseDir(t.TempDir()))
+			weeklyContent := prepareWeek(mgr, changes, tt.keepTransitions)
+			if err := mgr.WriteContentWithMerge(weeklyContent); err != nil {
+				t.Fatalf("WriteContentWithMerge() error = %v", err)
+			}
+
+			// Read back to check the history survives the content file
+			got, err := mgr.ReadExistingContent(2026, 5)
+			if err != nil {
+				t.Fatalf("ReadExistingContent() error = %v", err)
+			}
+			if len(got.Proposals) != 1 {
+				t.Fatalf("expected 1 proposal, got %d", len(got.Proposals))
+			}
+
+			p := got.Proposals[0]
+			if p.CurrentStatus != parser.StatusAccepted {
+				t.Errorf("CurrentStatus = %q, want %q", p.CurrentStatus, parser.StatusAccepted)
+			}
+			if p.PreviousStatus != parser.StatusLikelyAccept {
+				t.Errorf("PreviousStatus = %q, want %q", p.PreviousStatus, parser.StatusLikelyAccept)
+			}
+
+			if len(p.Transitions) != len(tt.wantTransitions) {
+				t.Fatalf("Transitions = %+v, want statuses %v", p.Transitions, tt.wantTransitions)
+			}
+			for i, want := range tt.wantTransitions {
+				if p.Transitions[i].CurrentStatus != want {
+					t.Errorf("Transitions[%d].CurrentStatus = %q, want %q", i, p.Transitions[i].CurrentStatus, want)
+				}
+			}
+			if tt.keepTransitions {
+				if !p.Transitions[0].ChangedAt.Equal(likelyAcceptAt) {
+					t.Errorf("Transitions[0].ChangedAt = %v, want %v", p.Transitions[0].ChangedAt, likelyAcceptAt)
+				}
+				if p.Transitions[1].CommentURL != changes[1].CommentURL {
+					t.Errorf("Transitions[1].CommentURL = %q, want %q", p.Transitions[1].CommentURL, changes[1].CommentURL)
+				}
+			}
+		})
+	}
+}
+
+func TestDeduplicateByIssue_TimestampTie(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	change := func(status parser.Status, commentURL string) parser.ProposalChange {
+		return parser.ProposalChange{
+			IssueNumber:   12345,
+			Title:         "proposal: tie",
+			CurrentStatus: status,
+			ChangedAt:     changedAt,
+			CommentURL:    commentURL,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		a, b    parser.ProposalChange
+		wantURL string
+	}{
+		{
+			name:    "later comment ID wins",
+			a:       change(parser.StatusLikelyAccept, "https://github.com/golang/go/issues/33502#issuecomment-999"),
+			b:       change(parser.StatusAccepted, "https://github.com/golang/go/issues/33502#issuecomment-1000"),
+			wantURL: "https://github.com/golang/go/issues/33502#issuecomment-1000",
+		},
+		{
+			name:    "greater URL wins without comment IDs",
+			a:       change(parser.StatusLikelyAccept, "https://example.com/a"),
+			b:       change(parser.StatusAccepted, "https://example.com/b"),
+			wantURL: "https://example.com/b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, order := range [][]parser.ProposalChange{{tt.a, tt.b}, {tt.b, tt.a}} {
+				got := deduplicateByIssue(order)
+				if len(got) != 1 {
+					t.Fatalf("expected 1 change, got %d", len(got))
+				}
+				if got[0].CommentURL != tt.wantURL {
+					t.Errorf("winner = %q, want %q", got[0].CommentURL, tt.wantURL)
+				}
+			}
+		})
+	}
+}
+
+func TestWeekCoverage(t *testing.T) {
+	t.Parallel()
+
+	weeklyContent := &content.WeeklyContent{
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 1},
+			{IssueNumber: 2},
+			{IssueNumber: 3},
+		},
+	}
+
+	got := weekCoverage("2026-W05", weeklyContent, []int{2})
+	if got.Week != "2026-W05" {
+		t.Errorf("Week = %q, want %q", got.Week, "2026-W05")
+	}
+	if !slices.Equal(got.Summarized, []int{1, 3}) {
+		t.Errorf("Summarized = %v, want [1 3]", got.Summarized)
+	}
+	if !slices.Equal(got.Fallback, []int{2}) {
+		t.Errorf("Fallback = %v, want [2]", got.Fallback)
+	}
+	if s := formatIssues(got.Summarized); s != "(#1, #3)" {
+		t.Errorf("formatIssues() = %q, want %q", s, "(#1, #3)")
+	}
+}
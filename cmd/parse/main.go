@@ -3,13 +3,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
 )
@@ -26,8 +31,41 @@ func run() error {
 	statePath := flag.String("state", "content/state.json", "Path to the state file")
 	changesPath := flag.String("output", "changes.json", "Path to output changes.json")
 	token := flag.String("token", "", "GitHub API token (optional, can also be set via GITHUB_TOKEN env var)")
+	owner := flag.String("owner", "", "Owner of the repository holding the minutes tracking issue (default: golang)")
+	repo := flag.String("repo", "", "Repository holding the minutes tracking issue (default: go)")
+	baseURL := flag.String("api-url", "", "GitHub API base URL, e.g. https://git.example.com/api/v3 for GitHub Enterprise (default: https://api.github.com)")
+	var issueNumbers issueList
+	flag.Var(&issueNumbers, "issue", "Minutes tracking issue number; repeat or comma-separate to merge several issues (default: 33502; required with -owner or -repo)")
+	commentURLTemplate := flag.String("comment-url-template", "", "Template for comment URLs when the API omits html_url ({issue} and {id} are replaced)")
+	archiveDir := flag.String("archive-dir", "", "Directory to keep a timestamped copy of each run's changes.json (optional)")
+	maxCommentBodySize := flag.Int("max-comment-body-size", parser.DefaultMaxCommentBodySize, "Maximum comment body size in bytes to parse (negative disables the limit)")
+	truncateOversized := flag.Bool("truncate-oversized-comments", false, "Parse the first -max-comment-body-size bytes of oversized comments instead of skipping them")
+	maxConcurrentRequests := flag.Int("max-concurrent-requests", 0, "Maximum number of GitHub API requests in flight at once (0 = unlimited)")
+	requestDelay := flag.Duration("request-delay", 0, "Politeness delay between consecutive GitHub API requests, including pages (e.g. 500ms)")
+	perPage := flag.Int("per-page", parser.MaxPerPage, "Number of comments requested per GitHub API page (at most 100)")
+	pageConcurrency := flag.Int("page-concurrency", 0, "Number of comment pages fetched in parallel once the page count is known (0 or 1 = serial)")
+	maxRetries := flag.Int("max-retries", 3, "Maximum retries of GitHub requests failing with 429, 500 or 503 (0 disables retries)")
+	retryBaseDelay := flag.Duration("retry-base-delay", parser.DefaultRetryBaseDelay, "Initial backoff before retrying a transient GitHub error; doubles on each retry")
+	summaryPath := flag.String("summary-json", "", "Path to write a machine-readable JSON summary of the run (optional)")
+	dryRun := flag.Bool("dry-run", false, "Fetch and parse changes and write the output without advancing the state file")
+	checkStateOnly := flag.Bool("check-state", false, "Print and validate the state file, then exit without fetching")
+	includeRaw := flag.Bool("include-raw", false, "Include the full minutes comment body of each change in changes.json")
+	sinceFlag := flag.String("since", "", "Reprocess comments updated at or after this RFC3339 time instead of resuming from the state file (optional)")
 	flag.Parse()
 
+	if *checkStateOnly {
+		return checkState(*statePath, os.Stdout)
+	}
+
+	var since time.Time
+	if *sinceFlag != "" {
+		t, err := time.Parse(time.RFC3339, *sinceFlag)
+		if err != nil {
+			return fmt.Errorf("invalid -since %q: %w", *sinceFlag, err)
+		}
+		since = t
+	}
+
 	// Get token from environment if not provided via flag
 	githubToken := *token
 	if githubToken == "" {
@@ -41,14 +79,72 @@ func run() error {
 	config := parseConfig{
 		statePath:   *statePath,
 		changesPath: *changesPath,
-		baseURL:     "", // Use default GitHub API URL
+		baseURL:     *baseURL,
 		token:       githubToken,
+		archiveDir:  *archiveDir,
+		summaryPath: *summaryPath,
+		dryRun:      *dryRun,
+		since:       since,
+		includeRaw:  *includeRaw,
 		stdout:      os.Stdout,
+
+		owner:              *owner,
+		repo:               *repo,
+		issueNumbers:       issueNumbers,
+		commentURLTemplate: *commentURLTemplate,
+		maxCommentBodySize: *maxCommentBodySize,
+		truncateOversized:  *truncateOversized,
+
+		maxConcurrentRequests: *maxConcurrentRequests,
+		requestDelay:          *requestDelay,
+		pageConcurrency:       *pageConcurrency,
+		perPage:               *perPage,
+		maxRetries:            *maxRetries,
+		retryBaseDelay:        *retryBaseDelay,
 	}
 
 	return runParse(ctx, config)
 }
 
+// issueList is a flag.Value collecting issue numbers from repeated or
+// comma-separated -issue flags.
+type issueList []int
+
+func (l *issueList) String() string {
+	if l == nil {
+		return ""
+	}
+	parts := make([]string, len(*l))
+	for i, n := range *l {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (l *issueList) Set(value string) error {
+	for part := range strings.SplitSeq(value, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid issue number %q", part)
+		}
+		*l = append(*l, n)
+	}
+	return nil
+}
+
+// sourceStatePath returns the state file of a tracking issue. The default
+// tracking issue, or the only issue of a run, uses statePath itself (plain);
+// the others get their own file keyed by issue number, e.g.
+// state-12345.json, so that each keeps its cursor whatever the order of the
+// -issue flags.
+func sourceStatePath(statePath string, issueNumber int, plain bool) string {
+	if plain {
+		return statePath
+	}
+	ext := filepath.Ext(statePath)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(statePath, ext), issueNumber, ext)
+}
+
 // parseConfig holds configuration for the parse operation.
 type parseConfig struct {
 	stdout      io.Writer
@@ -56,6 +152,175 @@ type parseConfig struct {
 	changesPath string
 	baseURL     string
 	token       string
+	// archiveDir, if non-empty, receives a timestamped copy of changes.json.
+	archiveDir string
+	// summaryPath, if non-empty, receives a JSON summary of the run.
+	summaryPath string
+	// dryRun leaves the state file unchanged.
+	dryRun bool
+	// since, if non-zero, overrides the state's cursor for this run.
+	since time.Time
+	// includeRaw records the minutes comment body in each change.
+	includeRaw bool
+	// owner, repo and issueNumbers select the minutes tracking issues.
+	// Changes of all issues are merged into one changes file.
+	owner        string
+	repo         string
+	issueNumbers []int
+	// commentURLTemplate builds comment URLs missing from the API response.
+	commentURLTemplate string
+	// maxCommentBodySize limits the comment body size that is parsed.
+	maxCommentBodySize int
+	// truncateOversized truncates oversized comments instead of skipping them.
+	truncateOversized bool
+	// maxConcurrentRequests limits the GitHub requests in flight at once.
+	maxConcurrentRequests int
+	// requestDelay spaces consecutive GitHub requests.
+	requestDelay time.Duration
+	// pageConcurrency is the number of comment pages fetched in parallel.
+	pageConcurrency int
+	// perPage is the number of comments requested per page.
+	perPage int
+	// maxRetries and retryBaseDelay control retries of transient errors.
+	maxRetries     int
+	retryBaseDelay time.Duration
+	// now returns the current time used for archive filenames.
+	// If nil, time.Now is used.
+	now func() time.Time
+}
+
+// archiveTimeFormat is the timestamp layout used for archived changes files.
+// Colons are replaced with hyphens so that names are valid on all platforms.
+const archiveTimeFormat = "2006-01-02T15-04-05Z"
+
+// Permission modes for the archive directory and archived files.
+const (
+	archiveDirPerm  = 0o755
+	archiveFilePerm = 0o644
+)
+
+// archiveChanges copies the written changes file into archiveDir under a
+// name derived from now, and returns the path of the archived file.
+func archiveChanges(changesPath, archiveDir string, now time.Time) (string, error) {
+	data, err := os.ReadFile(changesPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read changes file: %w", err)
+	}
+
+	if err := os.MkdirAll(archiveDir, archiveDirPerm); err != nil {
+		return "", fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	archivePath := filepath.Join(archiveDir, now.UTC().Format(archiveTimeFormat)+".json")
+	if err := os.WriteFile(archivePath, data, archiveFilePerm); err != nil {
+		return "", fmt.Errorf("failed to write archive file: %w", err)
+	}
+
+	return archivePath, nil
+}
+
+// summaryFilePerm is the permission mode of the run summary file.
+const summaryFilePerm = 0o644
+
+// runSummary is the machine-readable summary written by -summary-json.
+type runSummary struct {
+	StatusCounts  map[parser.Status]int `json:"status_counts"`
+	WeekRange     *weekRange            `json:"week_range,omitempty"`
+	LastCommentID string                `json:"last_comment_id"`
+	ChangesCount  int                   `json:"changes_count"`
+	PagesFetched  int                   `json:"pages_fetched"`
+}
+
+// weekRange is the span of ISO weeks (e.g. "2026-W05") covered by changes.
+type weekRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// buildRunSummary summarizes the changes of a run. The week range is omitted
+// when there are no changes.
+func buildRunSummary(changes []parser.ProposalChange, lastCommentID string, pagesFetched int) runSummary {
+	summary := runSummary{
+		StatusCounts:  make(map[parser.Status]int),
+		LastCommentID: lastCommentID,
+		ChangesCount:  len(changes),
+		PagesFetched:  pagesFetched,
+	}
+
+	var earliest, latest time.Time
+	for _, c := range changes {
+		summary.StatusCounts[c.CurrentStatus]++
+		if earliest.IsZero() || c.ChangedAt.Before(earliest) {
+			earliest = c.ChangedAt
+		}
+		if c.ChangedAt.After(latest) {
+			latest = c.ChangedAt
+		}
+	}
+	if len(changes) > 0 {
+		summary.WeekRange = &weekRange{From: isoWeek(earliest), To: isoWeek(latest)}
+	}
+
+	return summary
+}
+
+// isoWeek formats the ISO week of t like the week field of changes.json.
+func isoWeek(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// writeRunSummary writes summary to path as indented JSON.
+func writeRunSummary(path string, summary runSummary) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), summaryFilePerm); err != nil {
+		return fmt.Errorf("failed to write summary file: %w", err)
+	}
+	return nil
+}
+
+// checkState loads the state file, prints its cursor in a readable form and
+// validates it. It returns an error if the file is corrupt or inconsistent.
+func checkState(statePath string, w io.Writer) error {
+	state, err := parser.NewStateManager(statePath).LoadState()
+	if err != nil {
+		return fmt.Errorf("failed to load state %s: %w", statePath, err)
+	}
+
+	fmt.Fprintf(w, "state: %s\n", statePath)
+	if state.IsFresh {
+		fmt.Fprintln(w, "  (not found; the next run starts fresh from the latest comment)")
+		return nil
+	}
+
+	lastCommentID := state.LastCommentID
+	if lastCommentID == "" {
+		lastCommentID = "(none)"
+	}
+	lastProcessedAt := "(none)"
+	if !state.LastProcessedAt.IsZero() {
+		lastProcessedAt = state.LastProcessedAt.UTC().Format(time.RFC3339)
+	}
+
+	fmt.Fprintf(w, "  last comment ID:   %s\n", lastCommentID)
+	fmt.Fprintf(w, "  last processed at: %s\n", lastProcessedAt)
+	fmt.Fprintf(w, "  proposal statuses: %d\n", len(state.ProposalStatuses))
+	etag := state.ETag
+	if etag == "" {
+		etag = "(none)"
+	} else if !parser.ValidETag(etag) {
+		etag += " (malformed; ignored)"
+	}
+	fmt.Fprintf(w, "  etag:              %s\n", etag)
+
+	if err := state.Validate(); err != nil {
+		return fmt.Errorf("invalid state %s: %w", statePath, err)
+	}
+	fmt.Fprintln(w, "  ok")
+	return nil
 }
 
 // runParse executes the parse operation and writes results.
@@ -65,37 +330,115 @@ func runParse(ctx context.Context, config parseConfig) error {
 		Level: slog.LevelInfo,
 	}))
 
-	// Create state manager
-	stateManager := parser.NewStateManager(config.statePath)
-
-	// Create issue parser
-	parserConfig := parser.IssueParserConfig{
-		StateManager: stateManager,
-		Logger:       logger,
-		BaseURL:      config.baseURL,
-		Token:        config.token,
+	// A zero issue number selects the parser's default tracking issue
+	issueNumbers := config.issueNumbers
+	if len(issueNumbers) == 0 {
+		issueNumbers = []int{0}
 	}
 
-	issueParser, err := parser.NewIssueParser(parserConfig)
-	if err != nil {
-		return fmt.Errorf("failed to create issue parser: %w", err)
+	// Fetch changes from each tracking issue, each with its own state
+	var (
+		parsers      []*parser.IssueParser
+		sources      [][]parser.ProposalChange
+		pagesFetched int
+	)
+	defaultRepo := config.owner == "" && config.repo == ""
+	for _, issueNumber := range issueNumbers {
+		isDefault := issueNumber == 0 || (defaultRepo && issueNumber == parser.ProposalReviewIssueNumber)
+		statePath := sourceStatePath(config.statePath, issueNumber, isDefault || len(issueNumbers) == 1)
+		stateManager := parser.NewStateManager(statePath)
+
+		// Create issue parser
+		parserConfig := parser.IssueParserConfig{
+			StateManager: stateManager,
+			Logger:       logger,
+			BaseURL:      config.baseURL,
+			Token:        config.token,
+
+			Owner:                     config.owner,
+			Repo:                      config.repo,
+			IssueNumber:               issueNumber,
+			CommentURLTemplate:        config.commentURLTemplate,
+			MaxCommentBodySize:        config.maxCommentBodySize,
+			TruncateOversizedComments: config.truncateOversized,
+			MaxConcurrentRequests:     config.maxConcurrentRequests,
+			RequestDelay:              config.requestDelay,
+			MaxRetries:                config.maxRetries,
+			RetryBaseDelay:            config.retryBaseDelay,
+			DryRun:                    config.dryRun,
+			DeferStateSave:            true,
+			Since:                     config.since,
+			IncludeRaw:                config.includeRaw,
+			Concurrency:               config.pageConcurrency,
+			PerPage:                   config.perPage,
+		}
+
+		issueParser, err := parser.NewIssueParser(parserConfig)
+		if err != nil {
+			return fmt.Errorf("failed to create issue parser: %w", err)
+		}
+
+		changes, err := issueParser.FetchChanges(ctx)
+		if err != nil {
+			if len(issueNumbers) > 1 {
+				return fmt.Errorf("failed to fetch changes of issue %d: %w", issueNumber, err)
+			}
+			return fmt.Errorf("failed to fetch changes: %w", err)
+		}
+		parsers = append(parsers, issueParser)
+		sources = append(sources, changes)
+		pagesFetched += issueParser.PagesFetched()
 	}
+	primary := parsers[0]
 
-	// Fetch changes
-	changes, err := issueParser.FetchChanges(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to fetch changes: %w", err)
+	changes := sources[0]
+	if len(sources) > 1 {
+		changes = parser.MergeChanges(sources...)
 	}
 
 	// Write changes to JSON file
-	if err := issueParser.WriteChangesJSON(changes, config.changesPath); err != nil {
+	if err := primary.WriteChangesJSON(changes, config.changesPath); err != nil {
 		return fmt.Errorf("failed to write changes: %w", err)
 	}
 
+	// Advance each source's state only now that its changes are stored, so
+	// that a failure of any source leaves every cursor where it was
+	for _, issueParser := range parsers {
+		if err := issueParser.SaveState(); err != nil {
+			return fmt.Errorf("failed to advance state: %w", err)
+		}
+	}
+
+	// Keep a timestamped copy for auditing
+	if config.archiveDir != "" {
+		now := time.Now
+		if config.now != nil {
+			now = config.now
+		}
+		archivePath, err := archiveChanges(config.changesPath, config.archiveDir, now())
+		if err != nil {
+			return fmt.Errorf("failed to archive changes: %w", err)
+		}
+		logger.Info("archived changes", "path", archivePath)
+	}
+
+	// Write the machine-readable run summary for dashboards
+	if config.summaryPath != "" {
+		summary := buildRunSummary(changes, primary.LastCommentID(), pagesFetched)
+		if err := writeRunSummary(config.summaryPath, summary); err != nil {
+			return fmt.Errorf("failed to write run summary: %w", err)
+		}
+		logger.Info("wrote run summary", "path", config.summaryPath)
+	}
+
 	// Output has_changes flag for GitHub Actions
 	hasChanges := len(changes) > 0
 	fmt.Fprintf(config.stdout, "has_changes=%t\n", hasChanges)
 	fmt.Fprintf(config.stdout, "changes_count=%d\n", len(changes))
+	if config.dryRun {
+		fmt.Fprintln(config.stdout, "dry_run=true")
+		logger.Info("dry run: state file left unchanged", "path", config.statePath)
+	}
 
 	return nil
 }
@@ -4,11 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -201,6 +207,265 @@ func TestRunParse_StateFileUpdate(t *testing.T) {
 	}
 }
 
+func TestRunParse_DryRun(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		comments := []map[string]any{
+			{
+				"id":         int64(99999),
+				"body":       "**2026-01-30** / **@rsc**\n\n- #99999 **proposal: test**\n  - **accepted**\n",
+				"created_at": now.Format(time.RFC3339),
+				"updated_at": now.Format(time.RFC3339),
+				"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-99999",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(comments)
+	}))
+	t.Cleanup(server.Close)
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+	changesPath := filepath.Join(tmpDir, "changes.json")
+
+	if err := parser.NewStateManager(statePath).SaveState(&parser.State{
+		LastCommentID:   "100",
+		LastProcessedAt: now.Add(-time.Hour),
+	}); err != nil {
+		t.Fatalf("failed to save initial state: %v", err)
+	}
+	before, err := os.ReadFile(statePath)
+	if err != nil {
+		t.Fatalf("failed to read state.json: %v", err)
+	}
+
+	summaryPath := filepath.Join(tmpDir, "summary.json")
+
+	// Re-running a dry run processes the same comments again
+	for run := range 2 {
+		var stdout bytes.Buffer
+		config := parseConfig{
+			statePath:   statePath,
+			changesPath: changesPath,
+			baseURL:     server.URL,
+			dryRun:      true,
+			summaryPath: summaryPath,
+			stdout:      &stdout,
+		}
+
+		if err := runParse(context.Background(), config); err != nil {
+			t.Fatalf("run %d failed: %v", run, err)
+		}
+
+		after, err := os.ReadFile(statePath)
+		if err != nil {
+			t.Fatalf("failed to read state.json: %v", err)
+		}
+		if !bytes.Equal(before, after) {
+			t.Errorf("run %d: state.json changed in dry run:\nbefore: %s\nafter: %s", run, before, after)
+		}
+
+		output := stdout.String()
+		if !strings.Contains(output, "changes_count=1") {
+			t.Errorf("run %d: stdout = %q, want changes_count=1", run, output)
+		}
+		if !strings.Contains(output, "dry_run=true") {
+			t.Errorf("run %d: stdout = %q, want dry_run=true", run, output)
+		}
+
+		// The summary reports the processed comment, not the unchanged state
+		data, err := os.ReadFile(summaryPath)
+		if err != nil {
+			t.Fatalf("run %d: failed to read summary file: %v", run, err)
+		}
+		var summary runSummary
+		if err := json.Unmarshal(data, &summary); err != nil {
+			t.Fatalf("run %d: failed to unmarshal summary: %v", run, err)
+		}
+		if summary.LastCommentID != "99999" {
+			t.Errorf("run %d: LastCommentID = %q, want %q", run, summary.LastCommentID, "99999")
+		}
+	}
+}
+
+func TestRunParse_ArchiveDir(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		comments := []map[string]any{
+			{
+				"id":         int64(99999),
+				"body":       "**2026-01-30** / **@rsc**\n\n- #99999 **proposal: test**\n  - **accepted**\n",
+				"created_at": now.Format(time.RFC3339),
+				"updated_at": now.Format(time.RFC3339),
+				"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-99999",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(comments)
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	changesPath := filepath.Join(tmpDir, "changes.json")
+	archiveDir := filepath.Join(tmpDir, "changes-history")
+	runAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+
+	var stdout bytes.Buffer
+	config := parseConfig{
+		statePath:   filepath.Join(tmpDir, "state.json"),
+		changesPath: changesPath,
+		baseURL:     server.URL,
+		token:       "test-token",
+		archiveDir:  archiveDir,
+		now:         func() time.Time { return runAt },
+		stdout:      &stdout,
+	}
+
+	if err := runParse(context.Background(), config); err != nil {
+		t.Fatalf("runParse() error = %v", err)
+	}
+
+	archived, err := os.ReadFile(filepath.Join(archiveDir, "2026-01-30T12-00-00Z.json"))
+	if err != nil {
+		t.Fatalf("failed to read archive file: %v", err)
+	}
+	current, err := os.ReadFile(changesPath)
+	if err != nil {
+		t.Fatalf("failed to read changes.json: %v", err)
+	}
+	if !bytes.Equal(archived, current) {
+		t.Error("archive file should have the same content as changes.json")
+	}
+
+	var changesOutput parser.ChangesOutput
+	if err := json.Unmarshal(archived, &changesOutput); err != nil {
+		t.Fatalf("failed to unmarshal archive file: %v", err)
+	}
+	if len(changesOutput.Changes) != 1 || changesOutput.Changes[0].IssueNumber != 99999 {
+		t.Errorf("archived changes = %+v, want one change for #99999", changesOutput.Changes)
+	}
+}
+
+func TestRunParse_SummaryJSON(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		comments := []map[string]any{
+			{
+				"id":         int64(99999),
+				"body":       "**2026-01-30** / **@rsc**\n\n- #99998 **proposal: first**\n  - **accepted**\n- #99999 **proposal: second**\n  - **accepted**\n",
+				"created_at": now.Format(time.RFC3339),
+				"updated_at": now.Format(time.RFC3339),
+				"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-99999",
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(comments)
+	}))
+	t.Cleanup(server.Close)
+
+	tmpDir := t.TempDir()
+	summaryPath := filepath.Join(tmpDir, "summary.json")
+
+	var stdout bytes.Buffer
+	config := parseConfig{
+		statePath:   filepath.Join(tmpDir, "state.json"),
+		changesPath: filepath.Join(tmpDir, "changes.json"),
+		baseURL:     server.URL,
+		summaryPath: summaryPath,
+		stdout:      &stdout,
+	}
+
+	if err := runParse(context.Background(), config); err != nil {
+		t.Fatalf("runParse() error = %v", err)
+	}
+
+	// The GitHub Actions output is kept alongside the summary file
+	if !strings.Contains(stdout.String(), "changes_count=2") {
+		t.Errorf("stdout = %q, want changes_count=2", stdout.String())
+	}
+
+	data, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("failed to read summary file: %v", err)
+	}
+	var got runSummary
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("failed to unmarshal summary: %v", err)
+	}
+
+	if got.ChangesCount != 2 {
+		t.Errorf("ChangesCount = %d, want 2", got.ChangesCount)
+	}
+	if got.StatusCounts[parser.StatusAccepted] != 2 {
+		t.Errorf("StatusCounts = %v, want 2 accepted", got.StatusCounts)
+	}
+	if got.WeekRange == nil || got.WeekRange.From != "2026-W05" || got.WeekRange.To != "2026-W05" {
+		t.Errorf("WeekRange = %+v, want 2026-W05 to 2026-W05", got.WeekRange)
+	}
+	if got.LastCommentID != "99999" {
+		t.Errorf("LastCommentID = %q, want %q", got.LastCommentID, "99999")
+	}
+	if got.PagesFetched < 1 {
+		t.Errorf("PagesFetched = %d, want at least 1", got.PagesFetched)
+	}
+}
+
+func TestBuildRunSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		wantRange *weekRange
+		name      string
+		changes   []parser.ProposalChange
+		wantCount map[parser.Status]int
+	}{
+		{
+			name:      "no changes",
+			wantCount: map[parser.Status]int{},
+		},
+		{
+			name: "changes spanning weeks",
+			changes: []parser.ProposalChange{
+				{IssueNumber: 1, CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)},
+				{IssueNumber: 2, CurrentStatus: parser.StatusDeclined, ChangedAt: time.Date(2026, 1, 21, 0, 0, 0, 0, time.UTC)},
+				{IssueNumber: 3, CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC)},
+			},
+			wantRange: &weekRange{From: "2026-W04", To: "2026-W06"},
+			wantCount: map[parser.Status]int{parser.StatusAccepted: 2, parser.StatusDeclined: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := buildRunSummary(tt.changes, "123", 3)
+			if got.ChangesCount != len(tt.changes) {
+				t.Errorf("ChangesCount = %d, want %d", got.ChangesCount, len(tt.changes))
+			}
+			if !maps.Equal(got.StatusCounts, tt.wantCount) {
+				t.Errorf("StatusCounts = %v, want %v", got.StatusCounts, tt.wantCount)
+			}
+			if (got.WeekRange == nil) != (tt.wantRange == nil) ||
+				(got.WeekRange != nil && *got.WeekRange != *tt.wantRange) {
+				t.Errorf("WeekRange = %+v, want %+v", got.WeekRange, tt.wantRange)
+			}
+			if got.LastCommentID != "123" || got.PagesFetched != 3 {
+				t.Errorf("LastCommentID, PagesFetched = %q, %d, want %q, 3", got.LastCommentID, got.PagesFetched, "123")
+			}
+		})
+	}
+}
+
 func TestRunParse_OutputFormat(t *testing.T) {
 	t.Parallel()
 
@@ -254,3 +519,367 @@ func TestRunParse_OutputFormat(t *testing.T) {
 		t.Errorf("expected has_changes= in output, got: %s", output)
 	}
 }
+
+func TestCheckState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		content      string
+		wantContains []string
+		wantErr      bool
+	}{
+		{
+			name: "healthy state",
+			content: `{
+  "lastProcessedAt": "2026-01-30T12:00:00Z",
+  "proposalStatuses": {"12345": "accepted"},
+  "lastCommentId": "3802548881"
+}`,
+			wantContains: []string{
+				"last comment ID:   3802548881",
+				"last processed at: 2026-01-30T12:00:00Z",
+				"proposal statuses: 1",
+				"ok",
+			},
+		},
+		{
+			name:    "corrupt JSON",
+			content: `{"lastProcessedAt": "2026-01-30T12:00:00Z", "lastCommentId": `,
+			wantErr: true,
+		},
+		{
+			name: "inconsistent state",
+			content: `{
+  "lastProcessedAt": "2026-01-30T12:00:00Z",
+  "proposalStatuses": {"12345": "maybe"},
+  "lastCommentId": "not-a-number"
+}`,
+			wantContains: []string{"last comment ID:   not-a-number"},
+			wantErr:      true,
+		},
+		{
+			name:         "missing state file",
+			wantContains: []string{"not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			statePath := filepath.Join(t.TempDir(), "state.json")
+			if tt.content != "" {
+				if err := os.WriteFile(statePath, []byte(tt.content), 0o644); err != nil {
+					t.Fatalf("failed to write state file: %v", err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			err := checkState(statePath, &stdout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("output should contain %q, got:\n%s", want, stdout.String())
+				}
+			}
+			if tt.wantErr && strings.Contains(stdout.String(), "ok\n") {
+				t.Errorf("output should not report ok for an invalid state:\n%s", stdout.String())
+			}
+		})
+	}
+}
+
+func TestRunParse_MultipleIssues(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	newer := older.Add(24 * time.Hour)
+
+	// Each tracking issue has its own comments endpoint; #100 appears in both
+	comments := map[string]map[string]any{
+		"/repos/golang/go/issues/1/comments": {
+			"id":         int64(1001),
+			"body":       "**2026-01-28** / **@rsc**\n\n- #100 **proposal: shared**\n  - **likely accept**\n- #200 **proposal: first only**\n  - **active**\n",
+			"created_at": older.Format(time.RFC3339),
+			"updated_at": older.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/1#issuecomment-1001",
+		},
+		"/repos/golang/go/issues/2/comments": {
+			"id":         int64(2001),
+			"body":       "**2026-01-29** / **@rsc**\n\n- #100 **proposal: shared**\n  - **accepted**\n- #300 **proposal: second only**\n  - **declined**\n",
+			"created_at": newer.Format(time.RFC3339),
+			"updated_at": newer.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/2#issuecomment-2001",
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		comment, ok := comments[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]any{comment})
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+	changesPath := filepath.Join(tmpDir, "changes.json")
+
+	var issues issueList
+	if err := issues.Set("1, 2"); err != nil {
+		t.Fatalf("issueList.Set() error = %v", err)
+	}
+
+	var stdout bytes.Buffer
+	config := parseConfig{
+		statePath:    statePath,
+		changesPath:  changesPath,
+		baseURL:      server.URL,
+		token:        "test-token",
+		stdout:       &stdout,
+		issueNumbers: issues,
+	}
+	if err := runParse(context.Background(), config); err != nil {
+		t.Fatalf("runParse() error = %v", err)
+	}
+
+	data, err := os.ReadFile(changesPath)
+	if err != nil {
+		t.Fatalf("failed to read changes.json: %v", err)
+	}
+	var output parser.ChangesOutput
+	if err := json.Unmarshal(data, &output); err != nil {
+		t.Fatalf("failed to unmarshal changes.json: %v", err)
+	}
+
+	want := []struct {
+		status parser.Status
+		issue  int
+	}{
+		{issue: 200, status: parser.StatusActive},
+		{issue: 100, status: parser.StatusAccepted},
+		{issue: 300, status: parser.StatusDeclined},
+	}
+	if len(output.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(output.Changes), len(want), output.Changes)
+	}
+	for i, w := range want {
+		if got := output.Changes[i]; got.IssueNumber != w.issue || got.CurrentStatus != w.status {
+			t.Errorf("change %d = #%d %s, want #%d %s", i, got.IssueNumber, got.CurrentStatus, w.issue, w.status)
+		}
+	}
+
+	// Each issue keeps its own cursor
+	for path, wantID := range map[string]string{
+		filepath.Join(tmpDir, "state-1.json"): "1001",
+		filepath.Join(tmpDir, "state-2.json"): "2001",
+	} {
+		state, err := parser.NewStateManager(path).LoadState()
+		if err != nil {
+			t.Fatalf("failed to load %s: %v", path, err)
+		}
+		if state.LastCommentID != wantID {
+			t.Errorf("%s lastCommentId = %q, want %q", filepath.Base(path), state.LastCommentID, wantID)
+		}
+	}
+}
+
+func TestRunParse_MultipleIssuesStateByIssue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		// runs lists the -issue value of each run, sharing one state directory
+		runs []string
+		// wantStates maps each state file to the issue whose cursor it holds
+		wantStates map[string]int
+	}{
+		{
+			name:       "reordered issues keep their cursors",
+			runs:       []string{"1,2", "2,1"},
+			wantStates: map[string]int{"state-1.json": 1, "state-2.json": 2},
+		},
+		{
+			name:       "default issue keeps the plain state file in any position",
+			runs:       []string{"33502,1", "1,33502"},
+			wantStates: map[string]int{"state.json": 33502, "state-1.json": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			base := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+			var round atomic.Int64
+
+			// Each run adds one comment to every issue; the comment ID encodes
+			// the issue and the run
+			commentID := func(issue, run int) int64 { return int64(issue*10 + run) }
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var issue int
+				if _, err := fmt.Sscanf(r.URL.Path, "/repos/golang/go/issues/%d/comments", &issue); err != nil {
+					http.NotFound(w, r)
+					return
+				}
+				var comments []map[string]any
+				for run := 1; run <= int(round.Load()); run++ {
+					at := base.Add(time.Duration(run) * 24 * time.Hour)
+					id := commentID(issue, run)
+					comments = append(comments, map[string]any{
+						"id":         id,
+						"body":       fmt.Sprintf("**%s** / **@rsc**\n\n- #%d **proposal: run %d**\n  - **active**\n", at.Format(time.DateOnly), 100+run, run),
+						"created_at": at.Format(time.RFC3339),
+						"updated_at": at.Format(time.RFC3339),
+						"html_url":   fmt.Sprintf("https://github.com/golang/go/issues/%d#issuecomment-%d", issue, id),
+					})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(comments)
+			}))
+			t.Cleanup(server.Close)
+
+			tmpDir := t.TempDir()
+			for i, run := range tt.runs {
+				round.Store(int64(i + 1))
+
+				var issues issueList
+				if err := issues.Set(run); err != nil {
+					t.Fatalf("issueList.Set() error = %v", err)
+				}
+				config := parseConfig{
+					statePath:    filepath.Join(tmpDir, "state.json"),
+					changesPath:  filepath.Join(tmpDir, "changes.json"),
+					baseURL:      server.URL,
+					token:        "test-token",
+					stdout:       io.Discard,
+					issueNumbers: issues,
+				}
+				if err := runParse(context.Background(), config); err != nil {
+					t.Fatalf("runParse(-issue %s) error = %v", run, err)
+				}
+
+				for name, issue := range tt.wantStates {
+					state, err := parser.NewStateManager(filepath.Join(tmpDir, name)).LoadState()
+					if err != nil {
+						t.Fatalf("failed to load %s: %v", name, err)
+					}
+					if want := strconv.FormatInt(commentID(issue, i+1), 10); state.LastCommentID != want {
+						t.Errorf("after -issue %s, %s lastCommentId = %q, want %q (issue %d)", run, name, state.LastCommentID, want, issue)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunParse_MultipleIssuesFailureKeepsState(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	// The first issue has a new comment, the second one fails
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/golang/go/issues/1/comments" {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]any{{
+			"id":         int64(1001),
+			"body":       "**2026-01-28** / **@rsc**\n\n- #100 **proposal: first**\n  - **accepted**\n",
+			"created_at": now.Format(time.RFC3339),
+			"updated_at": now.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/1#issuecomment-1001",
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+	issueStatePath := filepath.Join(tmpDir, "state-1.json")
+	changesPath := filepath.Join(tmpDir, "changes.json")
+
+	if err := parser.NewStateManager(issueStatePath).SaveState(&parser.State{
+		LastCommentID:   "1000",
+		LastProcessedAt: now.Add(-time.Hour),
+	}); err != nil {
+		t.Fatalf("failed to save initial state: %v", err)
+	}
+	before, err := os.ReadFile(issueStatePath)
+	if err != nil {
+		t.Fatalf("failed to read state-1.json: %v", err)
+	}
+
+	var issues issueList
+	if err := issues.Set("1,2"); err != nil {
+		t.Fatalf("issueList.Set() error = %v", err)
+	}
+
+	var stdout bytes.Buffer
+	config := parseConfig{
+		statePath:    statePath,
+		changesPath:  changesPath,
+		baseURL:      server.URL,
+		token:        "test-token",
+		stdout:       &stdout,
+		issueNumbers: issues,
+	}
+	if err := runParse(context.Background(), config); err == nil {
+		t.Fatal("runParse() should fail when an issue cannot be fetched")
+	}
+
+	// The first issue's cursor must not skip the changes that were never written
+	after, err := os.ReadFile(issueStatePath)
+	if err != nil {
+		t.Fatalf("failed to read state-1.json: %v", err)
+	}
+	if !bytes.Equal(before, after) {
+		t.Errorf("state-1.json of the first issue changed:\nbefore: %s\nafter: %s", before, after)
+	}
+	if _, err := os.Stat(changesPath); !os.IsNotExist(err) {
+		t.Errorf("changes.json should not be written, stat error = %v", err)
+	}
+}
+
+func TestIssueList_Set(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    []int
+		wantErr bool
+	}{
+		{name: "repeated flags", values: []string{"1", "2"}, want: []int{1, 2}},
+		{name: "comma-separated", values: []string{"33502,42"}, want: []int{33502, 42}},
+		{name: "not a number", values: []string{"1,abc"}, wantErr: true},
+		{name: "non-positive", values: []string{"0"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got issueList
+			var err error
+			for _, v := range tt.values {
+				if err = got.Set(v); err != nil {
+					break
+				}
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("issueList = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Package main provides pruning of old weekly content directories, moving
+// them into an archive subtree (or deleting them) to bound the content tree.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	contentDir := flag.String("content", "content", "Path to content directory")
+	keep := flag.Int("keep-weeks", 52, "Prune weeks older than this many weeks, always keeping this many most recent weeks")
+	archiveDir := flag.String("archive-dir", "", "Directory archived weeks are moved into (default: <content>/archive)")
+	deleteWeeks := flag.Bool("delete", false, "Delete pruned weeks instead of archiving them")
+	flag.Parse()
+
+	if *keep < 1 {
+		return fmt.Errorf("-keep-weeks must be at least 1, got %d", *keep)
+	}
+
+	opts := []content.Option{
+		content.WithBaseDir(*contentDir),
+		content.WithArchiveDir(*archiveDir),
+		content.WithPruneDelete(*deleteWeeks),
+	}
+	cutoff := time.Now().AddDate(0, 0, -7*(*keep))
+	return prune(content.NewManager(opts...), cutoff, *keep, os.Stdout)
+}
+
+// prune prunes the weeks of mgr that ended before cutoff, except the keep
+// most recent ones, and reports each pruned week to w.
+func prune(mgr *content.Manager, cutoff time.Time, keep int, w io.Writer) error {
+	pruned, err := mgr.PruneOlderThan(cutoff, keep)
+	for _, week := range pruned {
+		fmt.Fprintf(w, "Pruned %s\n", week)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to prune content: %w", err)
+	}
+
+	if len(pruned) == 0 {
+		fmt.Fprintln(w, "No weeks to prune")
+	}
+	return nil
+}
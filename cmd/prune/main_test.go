@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	cutoff := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		wantOutput string
+		wantWeeks  []int
+		keep       int
+	}{
+		{name: "archives old weeks", keep: 1, wantOutput: "Pruned 2026/W01\nPruned 2026/W03\n", wantWeeks: []int{8}},
+		{name: "no-op when all weeks are kept", keep: 3, wantOutput: "No weeks to prune\n", wantWeeks: []int{1, 3, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			mgr := content.NewManager(content.WithBaseDir(dir))
+			for i, week := range []int{1, 3, 8} {
+				wc := &content.WeeklyContent{Year: 2026, Week: week, Proposals: []content.ProposalContent{{
+					IssueNumber: i + 1, Title: "proposal: prune", CurrentStatus: parser.StatusActive,
+					ChangedAt: changedAt, CommentURL: "https://example.com",
+				}}}
+				if err := mgr.WriteContent(wc); err != nil {
+					t.Fatalf("failed to write week %d: %v", week, err)
+				}
+			}
+
+			var out bytes.Buffer
+			if err := prune(mgr, cutoff, tt.keep, &out); err != nil {
+				t.Fatalf("prune() error = %v", err)
+			}
+			if out.String() != tt.wantOutput {
+				t.Errorf("output = %q, want %q", out.String(), tt.wantOutput)
+			}
+
+			weeks, err := mgr.ListAllWeeks()
+			if err != nil {
+				t.Fatalf("ListAllWeeks() error = %v", err)
+			}
+			var got []int
+			for _, w := range weeks {
+				got = append([]int{w.Week}, got...)
+			}
+			if len(got) != len(tt.wantWeeks) {
+				t.Fatalf("remaining weeks = %v, want %v", got, tt.wantWeeks)
+			}
+			for i := range got {
+				if got[i] != tt.wantWeeks[i] {
+					t.Errorf("remaining weeks = %v, want %v", got, tt.wantWeeks)
+					break
+				}
+			}
+
+			if strings.Contains(tt.wantOutput, "Pruned") {
+				if _, err := os.Stat(filepath.Join(dir, content.DefaultArchiveDir, "2026", "W01")); err != nil {
+					t.Errorf("archived week should exist: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Package main provides validation of content markdown files, e.g. as a
+// pre-commit gate for hand-edited proposal files.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	contentDir := flag.String("content", "content", "Path to content directory")
+	checkSummaries := flag.Bool("check-summary-length", true, "Report summaries that are missing or outside the recommended length")
+	flag.Parse()
+
+	return validate(*contentDir, *checkSummaries, os.Stdout)
+}
+
+// validate checks every proposal file under contentDir and reports all
+// problems at once. It returns a *content.ValidationError listing each
+// invalid file if any problem is found.
+func validate(contentDir string, checkSummaries bool, w io.Writer) error {
+	if _, err := os.Stat(contentDir); err != nil {
+		return fmt.Errorf("failed to access content directory: %w", err)
+	}
+
+	var checks []content.ProposalCheck
+	if checkSummaries {
+		checks = append(checks, content.CheckSummaryLength)
+	}
+
+	mgr := content.NewManager(content.WithBaseDir(contentDir))
+	if err := mgr.ValidateAll(checks...); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "All proposal files in %s are valid\n", contentDir)
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	validSummary := "## 概要\n\n" + strings.Repeat("要約", 150)
+	shortSummary := "## 概要\n\n短い要約"
+
+	tests := []struct {
+		setup          func(t *testing.T, dir string)
+		name           string
+		wantFiles      []string
+		checkSummaries bool
+		wantErrors     int
+	}{
+		{
+			name:           "valid content",
+			checkSummaries: true,
+		},
+		{
+			name: "reports every problem",
+			setup: func(t *testing.T, dir string) {
+				t.Helper()
+				writeProposal(t, dir, 6, content.ProposalContent{
+					IssueNumber: 3, Title: "proposal: short", CurrentStatus: parser.StatusActive,
+					ChangedAt: changedAt, CommentURL: "https://example.com", Summary: shortSummary,
+				})
+				writeFile(t, filepath.Join(dir, "2026", "W06", "proposal-2.md"),
+					"---\nissue_number: 2\ntitle: \"x\"\ncurrent_status: active\nchanged_at: yesterday\ncomment_url: https://example.com\n---\n")
+			},
+			checkSummaries: true,
+			wantErrors:     2,
+			wantFiles:      []string{"proposal-2.md", "proposal-3.md"},
+		},
+		{
+			name: "summary length check disabled",
+			setup: func(t *testing.T, dir string) {
+				t.Helper()
+				writeProposal(t, dir, 6, content.ProposalContent{
+					IssueNumber: 3, Title: "proposal: short", CurrentStatus: parser.StatusActive,
+					ChangedAt: changedAt, CommentURL: "https://example.com", Summary: shortSummary,
+				})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			writeProposal(t, dir, 5, content.ProposalContent{
+				IssueNumber: 1, Title: "proposal: valid", CurrentStatus: parser.StatusAccepted,
+				ChangedAt: changedAt, CommentURL: "https://example.com", Summary: validSummary,
+			})
+			if tt.setup != nil {
+				tt.setup(t, dir)
+			}
+
+			var out bytes.Buffer
+			err := validate(dir, tt.checkSummaries, &out)
+			if tt.wantErrors == 0 {
+				if err != nil {
+					t.Fatalf("validate() error = %v", err)
+				}
+				if !strings.Contains(out.String(), "valid") {
+					t.Errorf("output = %q, want a success message", out.String())
+				}
+				return
+			}
+
+			var verr *content.ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("validate() error = %v, want *content.ValidationError", err)
+			}
+			if len(verr.Errors) != tt.wantErrors {
+				t.Errorf("validate() reported %d errors, want %d: %v", len(verr.Errors), tt.wantErrors, err)
+			}
+			for _, file := range tt.wantFiles {
+				if !strings.Contains(err.Error(), file) {
+					t.Errorf("validate() error should mention %s, got:\n%v", file, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_MissingContentDir(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+	if err := validate(filepath.Join(t.TempDir(), "missing"), true, &out); err == nil {
+		t.Error("validate() on a missing directory should fail")
+	}
+}
+
+func writeProposal(t *testing.T, dir string, week int, p content.ProposalContent) {
+	t.Helper()
+
+	mgr := content.NewManager(content.WithBaseDir(dir))
+	if err := mgr.WriteContent(&content.WeeklyContent{Year: 2026, Week: week, Proposals: []content.ProposalContent{p}}); err != nil {
+		t.Fatalf("failed to write proposal: %v", err)
+	}
+}
+
+func writeFile(t *testing.T, path, data string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -8,6 +8,7 @@ require (
 	github.com/gopherlibs/feedhub v1.2.0
 	github.com/yuin/goldmark v1.7.16
 	github.com/yuin/goldmark-highlighting/v2 v2.0.0-20230729083705-37449abec8cc
+	golang.org/x/net v0.42.0
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -23,7 +24,6 @@ require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/natefinch/atomic v1.0.1 // indirect
 	golang.org/x/mod v0.26.0 // indirect
-	golang.org/x/net v0.42.0 // indirect
 	golang.org/x/sync v0.16.0 // indirect
 	golang.org/x/sys v0.34.0 // indirect
 	golang.org/x/tools v0.35.0 // indirect
@@ -34,4 +34,6 @@ tool (
 	github.com/mazrean/go-proposal-review-meeting/cmd/generator
 	github.com/mazrean/go-proposal-review-meeting/cmd/integrate
 	github.com/mazrean/go-proposal-review-meeting/cmd/parse
+	github.com/mazrean/go-proposal-review-meeting/cmd/prune
+	github.com/mazrean/go-proposal-review-meeting/cmd/validate
 )
@@ -0,0 +1,59 @@
+package content
+
+import (
+	"regexp"
+	"strings"
+)
+
+// OtherCategory is the category of proposals whose title names no package.
+const OtherCategory = "other"
+
+// packagePathRe matches an import path such as "context", "net/http" or
+// "x/tools/gopls".
+var packagePathRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*(/[a-z0-9][a-z0-9_.-]*)*$`)
+
+// CategoryFromTitle returns the package a proposal is about, following the
+// "proposal: net/http: add X" title convention. The "proposal:" prefix is
+// optional, and only the first of several comma-separated packages is used.
+// Titles without a recognizable package, such as "proposal: add Y" or
+// "proposal: Go 2: ...", get OtherCategory.
+func CategoryFromTitle(title string) string {
+	pkg, _, ok := strings.Cut(StripProposalPrefix(title), ":")
+	if !ok {
+		return OtherCategory
+	}
+	pkg, _, _ = strings.Cut(pkg, ",")
+	pkg = strings.TrimSpace(pkg)
+	if !packagePathRe.MatchString(pkg) || strings.Contains(pkg, "..") {
+		return OtherCategory
+	}
+	return pkg
+}
+
+// proposalPrefix is the prefix of Go proposal issue titles.
+const proposalPrefix = "proposal:"
+
+// StripProposalPrefix returns title without a leading "proposal:" prefix,
+// matched case-insensitively and with or without a following space, for
+// display on a site that is only about proposals. Surrounding whitespace is
+// trimmed. Titles without the prefix are returned trimmed.
+func StripProposalPrefix(title string) string {
+	rest := strings.TrimSpace(title)
+	if len(rest) >= len(proposalPrefix) && strings.EqualFold(rest[:len(proposalPrefix)], proposalPrefix) {
+		rest = strings.TrimSpace(rest[len(proposalPrefix):])
+	}
+	return rest
+}
+
+// AssignCategories sets the Category of each proposal in content that has
+// none, deriving it from the title with CategoryFromTitle.
+func (m *Manager) AssignCategories(content *WeeklyContent) {
+	if content == nil {
+		return
+	}
+	for i := range content.Proposals {
+		if content.Proposals[i].Category == "" {
+			content.Proposals[i].Category = CategoryFromTitle(content.Proposals[i].Title)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package content
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+// ExportData is the JSON document written by ExportJSON. Its field names are
+// snake_case and stable so that external tools can rely on them.
+type ExportData struct {
+	Weeks []ExportWeek `json:"weeks"`
+}
+
+// ExportWeek is a week of ExportData.
+type ExportWeek struct {
+	Proposals []ExportProposal `json:"proposals"`
+	Year      int              `json:"year"`
+	Week      int              `json:"week"`
+}
+
+// ExportProposal is a proposal of ExportWeek.
+type ExportProposal struct {
+	ChangedAt       time.Time      `json:"changed_at"`
+	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
+	Title           string         `json:"title"`
+	PreviousStatus  parser.Status  `json:"previous_status"`
+	CurrentStatus   parser.Status  `json:"current_status"`
+	CommentURL      string         `json:"comment_url"`
+	Reviewer        string         `json:"reviewer,omitempty"`
+	Category        string         `json:"category,omitempty"`
+	Summary         string         `json:"summary"`
+	SummaryLanguage string         `json:"summary_language,omitempty"`
+	SummaryEN       string         `json:"summary_en,omitempty"`
+	Links           []ExportLink   `json:"links"`
+	History         []ExportStatus `json:"history,omitempty"`
+	IssueNumber     int            `json:"issue_number"`
+}
+
+// ExportLink is a related link of ExportProposal.
+type ExportLink struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// ExportStatus is a status history entry of ExportProposal.
+type ExportStatus struct {
+	ChangedAt time.Time     `json:"changed_at"`
+	Status    parser.Status `json:"status"`
+}
+
+// ExportJSON writes every week of the content tree (see ListAllWeeks) to w
+// as indented JSON, newest week first.
+func (m *Manager) ExportJSON(w io.Writer) error {
+	weeks, err := m.ListAllWeeks()
+	if err != nil {
+		return fmt.Errorf("failed to list weeks: %w", err)
+	}
+	return WriteJSON(w, weeks)
+}
+
+// WriteJSON writes weeks to w as an indented ExportData document, keeping
+// the order of weeks and proposals. Times are written in UTC.
+func WriteJSON(w io.Writer, weeks []*WeeklyContent) error {
+	data := ExportData{Weeks: make([]ExportWeek, 0, len(weeks))}
+	for _, wc := range weeks {
+		week := ExportWeek{Year: wc.Year, Week: wc.Week, Proposals: make([]ExportProposal, 0, len(wc.Proposals))}
+		for _, p := range wc.Proposals {
+			week.Proposals = append(week.Proposals, exportProposal(p))
+		}
+		data.Weeks = append(data.Weeks, week)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(data); err != nil {
+		return fmt.Errorf("failed to encode content: %w", err)
+	}
+	return nil
+}
+
+// ReadJSON reads a document written by WriteJSON back into weekly contents.
+// Derived fields (FullContent, ChangedThisWeek) are not part of the export;
+// ChangedThisWeek is recomputed.
+func ReadJSON(r io.Reader) ([]*WeeklyContent, error) {
+	var data ExportData
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode content: %w", err)
+	}
+
+	weeks := make([]*WeeklyContent, 0, len(data.Weeks))
+	for _, week := range data.Weeks {
+		wc := &WeeklyContent{Year: week.Year, Week: week.Week}
+		for _, p := range week.Proposals {
+			wc.Proposals = append(wc.Proposals, importProposal(p))
+		}
+		wc.MarkChangedThisWeek()
+		weeks = append(weeks, wc)
+	}
+	return weeks, nil
+}
+
+// exportProposal converts p to its exported form.
+func exportProposal(p ProposalContent) ExportProposal {
+	e := ExportProposal{
+		IssueNumber:     p.IssueNumber,
+		Title:           p.Title,
+		PreviousStatus:  p.PreviousStatus,
+		CurrentStatus:   p.CurrentStatus,
+		ChangedAt:       p.ChangedAt.UTC(),
+		CommentURL:      p.CommentURL,
+		Reviewer:        p.Reviewer,
+		Category:        p.Category,
+		Summary:         p.Summary,
+		SummaryLanguage: p.SummaryLanguage,
+		SummaryEN:       p.SummaryEN,
+		Links:           make([]ExportLink, 0, len(p.Links)),
+	}
+	if !p.UpdatedAt.IsZero() {
+		updatedAt := p.UpdatedAt.UTC()
+		e.UpdatedAt = &updatedAt
+	}
+	for _, link := range p.Links {
+		e.Links = append(e.Links, ExportLink(link))
+	}
+	for _, h := range p.History {
+		e.History = append(e.History, ExportStatus{ChangedAt: h.ChangedAt.UTC(), Status: h.Status})
+	}
+	return e
+}
+
+// importProposal converts an exported proposal back to ProposalContent.
+func importProposal(e ExportProposal) ProposalContent {
+	p := ProposalContent{
+		IssueNumber:     e.IssueNumber,
+		Title:           e.Title,
+		PreviousStatus:  e.PreviousStatus,
+		CurrentStatus:   e.CurrentStatus,
+		ChangedAt:       e.ChangedAt,
+		CommentURL:      e.CommentURL,
+		Reviewer:        e.Reviewer,
+		Category:        e.Category,
+		Summary:         e.Summary,
+		SummaryLanguage: e.SummaryLanguage,
+		SummaryEN:       e.SummaryEN,
+	}
+	if e.UpdatedAt != nil {
+		p.UpdatedAt = *e.UpdatedAt
+	}
+	for _, link := range e.Links {
+		p.Links = append(p.Links, Link(link))
+	}
+	for _, h := range e.History {
+		p.History = append(p.History, StatusChange{ChangedAt: h.ChangedAt, Status: h.Status})
+	}
+	return p
+}
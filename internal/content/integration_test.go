@@ -232,7 +232,7 @@ func TestIntegration_FallbackProcessing(t *testing.T) {
 	}
 
 	// Apply fallback (Requirement 3.4)
-	if err := mgr.ApplyFallback(content); err != nil {
+	if _, err := mgr.ApplyFallback(content); err != nil {
 		t.Fatalf("ApplyFallback() error = %v", err)
 	}
 
@@ -336,7 +336,7 @@ func TestIntegration_PartialSummaryFallback(t *testing.T) {
 	}
 
 	// Apply fallback for proposals without AI summary
-	if err := mgr.ApplyFallback(content); err != nil {
+	if _, err := mgr.ApplyFallback(content); err != nil {
 		t.Fatalf("ApplyFallback() error = %v", err)
 	}
 
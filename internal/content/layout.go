@@ -0,0 +1,119 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+)
+
+// LayoutFunc maps an ISO year and week to the slash-separated directory,
+// relative to the base directory, holding the week's proposal files.
+// Different weeks must map to different directories.
+type LayoutFunc func(year, week int) string
+
+// NestedLayout is the default layout, one directory per year with a
+// directory per week: YYYY/WXX (e.g. 2026/W05).
+func NestedLayout(year, week int) string {
+	return weekDirPath(year, week)
+}
+
+// FlatLayout puts every week directly under the base directory: YYYY-WXX
+// (e.g. 2026-W05).
+func FlatLayout(year, week int) string {
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// WithLayout sets the directory layout of weeks under the base directory.
+// It is used both to write content and to discover existing weeks, so it
+// must not change between runs. The default is NestedLayout.
+func WithLayout(layout LayoutFunc) Option {
+	return func(m *Manager) {
+		m.layout = layout
+	}
+}
+
+// weekDir returns the directory of the given week relative to the base
+// directory according to the configured layout.
+func (m *Manager) weekDir(year, week int) string {
+	if m.layout == nil {
+		return NestedLayout(year, week)
+	}
+	return m.layout(year, week)
+}
+
+// layoutNumberRe matches the numbers in a directory path that may encode
+// its year and week.
+var layoutNumberRe = regexp.MustCompile(`\d+`)
+
+// matchWeekDir reports the week stored in the directory rel (relative to the
+// base directory), if any. Since a layout cannot be inverted in general,
+// each ordered pair of numbers in rel is tried as year and week and kept if
+// the layout maps it back to rel.
+func (m *Manager) matchWeekDir(rel string) (weekKey, bool) {
+	var numbers []int
+	for _, s := range layoutNumberRe.FindAllString(rel, -1) {
+		if n, err := strconv.Atoi(s); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+
+	for i, year := range numbers {
+		for j, week := range numbers {
+			if i == j || week < 1 || week > 53 {
+				continue
+			}
+			if m.weekDir(year, week) == rel {
+				return weekKey{year: year, week: week}, true
+			}
+		}
+	}
+	return weekKey{}, false
+}
+
+// listWeekDirs scans the content directory for week directories of the
+// configured layout without reading their contents.
+func (m *Manager) listWeekDirs() ([]weekKey, error) {
+	fsys := m.readFS()
+
+	// Check if base directory exists
+	if _, err := fs.Stat(fsys, m.baseDir); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	var keys []weekKey
+	var walk func(rel string) error
+	walk = func(rel string) error {
+		dirPath := m.baseDir
+		if rel != "" {
+			dirPath = m.readPath(m.baseDir, rel)
+		}
+		entries, err := fs.ReadDir(fsys, dirPath)
+		if err != nil {
+			return fmt.Errorf("failed to read directory %s: %w", dirPath, err)
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+
+			childRel := path.Join(rel, entry.Name())
+			if key, ok := m.matchWeekDir(childRel); ok {
+				keys = append(keys, key)
+				continue
+			}
+			if err := walk(childRel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(""); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
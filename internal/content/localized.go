@@ -0,0 +1,81 @@
+package content
+
+import (
+	"sort"
+)
+
+// DefaultSummaryLanguage is the language of summary files without a
+// language suffix (e.g. "12345.md").
+const DefaultSummaryLanguage = "ja"
+
+// LocalizedSummary maps a language code (e.g. "ja", "en") to the summary
+// written in that language.
+type LocalizedSummary map[string]string
+
+// Select returns the summary in lang, falling back to fallback and then to
+// the first available language in sorted order, so that a summary in any
+// language is preferred over a generated fallback. It also returns the
+// language of the selected summary.
+func (ls LocalizedSummary) Select(lang, fallback string) (summary, selected string, ok bool) {
+	for _, l := range []string{lang, fallback} {
+		if s, found := ls[l]; found && s != "" {
+			return s, l, true
+		}
+	}
+
+	langs := make([]string, 0, len(ls))
+	for l, s := range ls {
+		if s != "" {
+			langs = append(langs, l)
+		}
+	}
+	if len(langs) == 0 {
+		return "", "", false
+	}
+	sort.Strings(langs)
+	return ls[langs[0]], langs[0], true
+}
+
+// summaryLanguages returns the preferred and default summary languages.
+func (m *Manager) summaryLanguages() (lang, fallback string) {
+	fallback = m.defaultSummaryLang
+	if fallback == "" {
+		fallback = DefaultSummaryLanguage
+	}
+	lang = m.summaryLang
+	if lang == "" {
+		lang = fallback
+	}
+	return lang, fallback
+}
+
+// IntegrateLocalizedSummaries integrates summaries like IntegrateSummaries,
+// selecting for each proposal the summary in the configured language (see
+// WithSummaryLanguage) with fallback to the default language. The selected
+// language is recorded in ProposalContent.SummaryLanguage.
+func (m *Manager) IntegrateLocalizedSummaries(content *WeeklyContent, summaries map[int]LocalizedSummary) error {
+	if content == nil {
+		return nil
+	}
+
+	lang, fallback := m.summaryLanguages()
+	selected := make(map[int]string, len(summaries))
+	languages := make(map[int]string, len(summaries))
+	for issue, ls := range summaries {
+		if s, l, ok := ls.Select(lang, fallback); ok {
+			selected[issue] = s
+			languages[issue] = l
+		}
+	}
+
+	if err := m.IntegrateSummaries(content, selected); err != nil {
+		return err
+	}
+
+	for i := range content.Proposals {
+		if l, ok := languages[content.Proposals[i].IssueNumber]; ok {
+			content.Proposals[i].SummaryLanguage = l
+		}
+	}
+	return nil
+}
@@ -3,17 +3,26 @@ package content
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+	"gopkg.in/yaml.v3"
 )
 
 // File permission constants.
@@ -35,15 +44,32 @@ type Link struct {
 
 // ProposalContent represents the content for a single proposal.
 type ProposalContent struct {
-	ChangedAt      time.Time     `yaml:"changed_at"`
-	Title          string        `yaml:"title"`
-	PreviousStatus parser.Status `yaml:"previous_status"`
-	CurrentStatus  parser.Status `yaml:"current_status"`
-	CommentURL     string        `yaml:"comment_url"`
-	Summary        string        `yaml:"-"` // For weekly index pages (only ## 概要 section)
-	FullContent    string        `yaml:"-"` // For detail pages (all sections except ## 関連リンク)
-	Links          []Link        `yaml:"related_issues"`
-	IssueNumber    int           `yaml:"issue_number"`
+	ChangedAt       time.Time      `yaml:"changed_at"`
+	UpdatedAt       time.Time      `yaml:"updated_at"` // Last edit of summary/links (see WithClock); zero if never edited
+	Title           string         `yaml:"title"`
+	PreviousStatus  parser.Status  `yaml:"previous_status"`
+	CurrentStatus   parser.Status  `yaml:"current_status"`
+	CommentURL      string         `yaml:"comment_url"`
+	Reviewer        string         `yaml:"reviewer"`         // GitHub login of the minutes recorder; empty if unknown
+	Category        string         `yaml:"category"`         // Package the proposal is about (e.g. net/http); see CategoryFromTitle
+	Summary         string         `yaml:"-"`                // For weekly index pages (only ## 概要 section)
+	SummaryLanguage string         `yaml:"summary_language"` // Language of Summary; empty if unknown
+	SummaryEN       string         `yaml:"-"`                // English translation of the summary (## Summary (EN) section); optional
+	FullContent     string         `yaml:"-"`                // For detail pages (all sections except ## 関連リンク)
+	Body            string         `yaml:"-"`                // Raw markdown before ## 関連リンク as read; written verbatim when set
+	Links           []Link         `yaml:"related_issues"`
+	Transitions     []Transition   `yaml:"transitions"` // Same-week transitions, oldest first; empty unless kept
+	History         []StatusChange `yaml:"history"`     // Statuses across merges, oldest first; empty until the status changes
+	IssueNumber     int            `yaml:"issue_number"`
+	ChangedThisWeek bool           `yaml:"-"` // Derived: ChangedAt falls in the ISO week of the containing WeeklyContent
+}
+
+// LastModified returns UpdatedAt if it is later than ChangedAt, otherwise ChangedAt.
+func (p ProposalContent) LastModified() time.Time {
+	if p.UpdatedAt.After(p.ChangedAt) {
+		return p.UpdatedAt
+	}
+	return p.ChangedAt
 }
 
 // WeeklyContent represents the content for a single week.
@@ -54,12 +80,72 @@ type WeeklyContent struct {
 	Week      int
 }
 
+// MarkChangedThisWeek sets ChangedThisWeek on each proposal whose ChangedAt
+// falls in the ISO week of wc, telling genuinely new changes apart from
+// proposals carried over from an earlier week.
+func (wc *WeeklyContent) MarkChangedThisWeek() {
+	for i := range wc.Proposals {
+		year, week := wc.Proposals[i].ChangedAt.ISOWeek()
+		wc.Proposals[i].ChangedThisWeek = year == wc.Year && week == wc.Week
+	}
+}
+
 // Manager handles the creation and management of weekly content.
 type Manager struct {
-	baseDir      string
-	summariesDir string
+	baseDir            string
+	summariesDir       string
+	readConcurrency    int
+	fsys               fs.FS
+	postProcess        SummaryPostProcessor
+	checkIssues        bool
+	lineEnding         LineEnding
+	summaryLang        string
+	defaultSummaryLang string
+	archiveDir         string
+	linkHosts          []string
+	headings           sectionHeadings
+	maxSummaryLen      int
+	pruneDelete        bool
+	layout             LayoutFunc
+	logger             *slog.Logger
+	now                func() time.Time
+}
+
+// Default section headings of proposal files.
+const (
+	// DefaultSummaryHeading is the heading of the summary section shown on index pages.
+	DefaultSummaryHeading = "概要"
+	// DefaultLinksHeading is the heading of the related links section.
+	DefaultLinksHeading = "関連リンク"
+	// SummaryENHeading is the heading of the English summary section.
+	SummaryENHeading = "Summary (EN)"
+)
+
+// sectionHeadings holds the level-2 heading texts (without "## ") that
+// delimit sections of proposal files.
+type sectionHeadings struct {
+	summary string
+	links   string
 }
 
+// defaultHeadings are the section headings used unless configured otherwise.
+var defaultHeadings = sectionHeadings{summary: DefaultSummaryHeading, links: DefaultLinksHeading}
+
+// LineEnding is the line terminator used when writing content files.
+type LineEnding string
+
+// Supported line endings.
+const (
+	// LineEndingLF terminates lines with "\n" (the default).
+	LineEndingLF LineEnding = "\n"
+	// LineEndingCRLF terminates lines with "\r\n", e.g. for Windows checkouts.
+	LineEndingCRLF LineEnding = "\r\n"
+)
+
+// SummaryPostProcessor transforms the summary of the proposal with the given
+// issue number before it is stored in the content.
+type SummaryPostProcessor func(issue int, summary string) string
+
 // Option is a functional option for configuring Manager.
 type Option func(*Manager)
 
@@ -77,11 +163,142 @@ func WithSummariesDir(dir string) Option {
 	}
 }
 
+// WithReadConcurrency sets the maximum number of summary files read
+// concurrently by ReadSummaries. Values less than 2 read files serially.
+func WithReadConcurrency(n int) Option {
+	return func(m *Manager) {
+		m.readConcurrency = n
+	}
+}
+
+// WithFS makes the Manager read content and summaries from fsys instead of
+// the local file system, e.g. a snapshot of a git tree. The base and
+// summaries directories are then interpreted as slash-separated paths
+// within fsys ("." for its root). Writes still go to the local file system.
+func WithFS(fsys fs.FS) Option {
+	return func(m *Manager) {
+		m.fsys = fsys
+	}
+}
+
+// WithSummaryPostProcessor sets a hook applied to each summary during
+// IntegrateSummaries, e.g. to redact URLs or normalize terminology.
+// Related links are still extracted from the original summary.
+func WithSummaryPostProcessor(fn SummaryPostProcessor) Option {
+	return func(m *Manager) {
+		m.postProcess = fn
+	}
+}
+
+// WithIssueMismatchCheck enables a consistency check in ReadSummaries that
+// logs a warning when a summary mostly references a different issue than the
+// one its filename names, e.g. after a copy-paste mistake.
+func WithIssueMismatchCheck(enabled bool) Option {
+	return func(m *Manager) {
+		m.checkIssues = enabled
+	}
+}
+
+// WithLineEnding sets the line ending of written content files.
+// Files are read correctly with either line ending regardless of this option.
+func WithLineEnding(le LineEnding) Option {
+	return func(m *Manager) {
+		m.lineEnding = le
+	}
+}
+
+// WithSummaryLanguage sets the preferred language of summaries, selected from
+// language-suffixed summary files such as "12345.en.md". Proposals without a
+// summary in this language fall back to the default summary language.
+func WithSummaryLanguage(lang string) Option {
+	return func(m *Manager) {
+		m.summaryLang = lang
+	}
+}
+
+// WithDefaultSummaryLanguage sets the language of summary files without a
+// language suffix, which is also the fallback when a summary is missing in
+// the preferred language. Defaults to DefaultSummaryLanguage.
+func WithDefaultSummaryLanguage(lang string) Option {
+	return func(m *Manager) {
+		m.defaultSummaryLang = lang
+	}
+}
+
+// WithMaxSummaryLength truncates summaries longer than n runes when writing
+// content, appending "…" so the result is at most n runes. Values less than 1
+// disable truncation (the default).
+func WithMaxSummaryLength(n int) Option {
+	return func(m *Manager) {
+		m.maxSummaryLen = n
+	}
+}
+
+// WithSummaryHeading sets the heading text (without "## ") of the summary
+// section that is extracted from proposal files for index pages.
+// The default is DefaultSummaryHeading.
+func WithSummaryHeading(s string) Option {
+	return func(m *Manager) {
+		m.headings.summary = s
+	}
+}
+
+// WithLinksHeading sets the heading text (without "## ") of the related links
+// section written to and recognized in proposal files.
+// The default is DefaultLinksHeading.
+func WithLinksHeading(s string) Option {
+	return func(m *Manager) {
+		m.headings.links = s
+	}
+}
+
+// DefaultAllowedLinkHosts are the hosts, besides golang/go issues, whose links
+// are extracted from summaries into the related links by default.
+var DefaultAllowedLinkHosts = []string{"go.dev", "go.googlesource.com"}
+
+// WithAllowedLinkHosts sets the host patterns (path.Match syntax, e.g.
+// "*.golang.org") of summary links extracted into the related links in
+// addition to golang/go issue links. It replaces DefaultAllowedLinkHosts;
+// no patterns restricts extraction to golang/go issue links.
+func WithAllowedLinkHosts(patterns ...string) Option {
+	return func(m *Manager) {
+		m.linkHosts = patterns
+	}
+}
+
+// WithLogger sets the logger reporting integrated summaries, applied
+// fallbacks, merges with existing content, written files and the warnings of
+// WithIssueMismatchCheck. By default nothing is logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(m *Manager) {
+		if logger != nil {
+			m.logger = logger
+		}
+	}
+}
+
+// WithClock sets the function returning the time stamped into UpdatedAt when
+// a write changes the summary or related links of existing content.
+// Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(m *Manager) {
+		if now != nil {
+			m.now = now
+		}
+	}
+}
+
 // NewManager creates a new content Manager with the given options.
 func NewManager(opts ...Option) *Manager {
 	m := &Manager{
-		baseDir:      "content",
-		summariesDir: "summaries",
+		baseDir:         "content",
+		summariesDir:    "summaries",
+		readConcurrency: 1,
+		lineEnding:      LineEndingLF,
+		headings:        defaultHeadings,
+		linkHosts:       DefaultAllowedLinkHosts,
+		logger:          slog.New(slog.DiscardHandler),
+		now:             time.Now,
 	}
 	for _, opt := range opts {
 		opt(m)
@@ -128,17 +345,21 @@ func (m *Manager) PrepareContent(changes []parser.ProposalChange) *WeeklyContent
 			CurrentStatus:  change.CurrentStatus,
 			ChangedAt:      change.ChangedAt,
 			CommentURL:     change.CommentURL,
+			Reviewer:       change.Reviewer,
 			Summary:        "",
 			Links:          links,
 		}
 	}
 
-	return &WeeklyContent{
+	wc := &WeeklyContent{
 		Year:      year,
 		Week:      week,
 		Proposals: proposals,
 		CreatedAt: time.Now(),
 	}
+	wc.MarkChangedThisWeek()
+	m.AssignCategories(wc)
+	return wc
 }
 
 // WriteContent writes the weekly content to the filesystem.
@@ -148,7 +369,7 @@ func (m *Manager) WriteContent(content *WeeklyContent) error {
 	}
 
 	// Create the directory
-	dirPath := filepath.Join(m.baseDir, weekDirPath(content.Year, content.Week))
+	dirPath := filepath.Join(m.baseDir, m.weekDir(content.Year, content.Week))
 	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
 		return fmt.Errorf("failed to create directory %s: %w", dirPath, err)
 	}
@@ -158,16 +379,27 @@ func (m *Manager) WriteContent(content *WeeklyContent) error {
 		filename := proposalFilename(proposal.IssueNumber)
 		filePath := filepath.Join(dirPath, filename)
 
-		fileContent := generateMarkdown(proposal)
+		if m.maxSummaryLen > 0 {
+			if truncated := truncateSummary(proposal.Summary, m.maxSummaryLen); truncated != proposal.Summary {
+				// The truncated summary replaces any body read from an existing file
+				proposal.Summary = truncated
+				proposal.Body = ""
+			}
+		}
+
+		fileContent := generateMarkdown(proposal, m.lineEnding, m.headings)
 		if err := os.WriteFile(filePath, []byte(fileContent), filePerm); err != nil {
 			return fmt.Errorf("failed to write file %s: %w", filePath, err)
 		}
+		m.logger.Debug("wrote proposal file", "issue", proposal.IssueNumber, "path", filePath)
 	}
 
+	m.logger.Info("wrote week content", "dir", dirPath, "proposals", len(content.Proposals))
 	return nil
 }
 
-// weekDirPath returns the directory path for the given year and week.
+// weekDirPath returns the directory path for the given year and week in
+// the default NestedLayout.
 func weekDirPath(year, week int) string {
 	return fmt.Sprintf("%d/W%02d", year, week)
 }
@@ -178,7 +410,8 @@ func proposalFilename(issueNumber int) string {
 }
 
 // generateMarkdown generates the markdown content for a proposal.
-func generateMarkdown(p ProposalContent) string {
+// All lines, including those of the summary, end with lineEnding.
+func generateMarkdown(p ProposalContent, lineEnding LineEnding, headings sectionHeadings) string {
 	var b strings.Builder
 
 	// Frontmatter
@@ -188,34 +421,63 @@ func generateMarkdown(p ProposalContent) string {
 	fmt.Fprintf(&b, "previous_status: %s\n", p.PreviousStatus)
 	fmt.Fprintf(&b, "current_status: %s\n", p.CurrentStatus)
 	fmt.Fprintf(&b, "changed_at: %s\n", p.ChangedAt.UTC().Format(time.RFC3339))
+	if !p.UpdatedAt.IsZero() {
+		fmt.Fprintf(&b, "updated_at: %s\n", p.UpdatedAt.UTC().Format(time.RFC3339))
+	}
 	fmt.Fprintf(&b, "comment_url: %s\n", p.CommentURL)
+	if p.Reviewer != "" {
+		fmt.Fprintf(&b, "reviewer: %s\n", p.Reviewer)
+	}
+	category := p.Category
+	if category == "" {
+		category = CategoryFromTitle(p.Title)
+	}
+	fmt.Fprintf(&b, "category: %s\n", category)
+	if p.SummaryLanguage != "" {
+		fmt.Fprintf(&b, "summary_language: %s\n", p.SummaryLanguage)
+	}
 
 	b.WriteString("related_issues:\n")
 	for _, link := range p.Links {
 		fmt.Fprintf(&b, "  - title: %q\n", link.Title)
 		fmt.Fprintf(&b, "    url: %s\n", link.URL)
 	}
+	writeTransitions(&b, p.Transitions)
+	writeHistory(&b, p.History)
 
 	b.WriteString("---\n")
 
-	// Body section
-	if p.Summary != "" {
-		b.WriteString(p.Summary)
+	// Body section: the raw body read from an existing file takes precedence
+	// so that sections other than the summary survive a read-write round trip
+	body := p.Summary
+	if p.Body != "" {
+		body = p.Body
+	}
+	if body != "" {
+		b.WriteString(body)
 		b.WriteString("\n")
 	}
+	if p.SummaryEN != "" && !strings.Contains(body, "## "+SummaryENHeading) {
+		fmt.Fprintf(&b, "\n## %s\n\n%s\n", SummaryENHeading, p.SummaryEN)
+	}
 
-	b.WriteString("\n## 関連リンク\n\n")
+	fmt.Fprintf(&b, "\n## %s\n\n", headings.links)
 	for _, link := range p.Links {
 		fmt.Fprintf(&b, "- [%s](%s)\n", link.Title, link.URL)
 	}
 
-	return b.String()
+	out := strings.ReplaceAll(b.String(), "\r\n", "\n")
+	if lineEnding != "" && lineEnding != LineEndingLF {
+		out = strings.ReplaceAll(out, "\n", string(lineEnding))
+	}
+	return out
 }
 
 // MergeContent merges new content into existing content for the same week.
 // If existing is nil, returns the new content as-is.
 // For proposals that exist in both, it updates the status and previous_status
-// while preserving the summary (if new summary is empty).
+// while preserving the summary (if new summary is empty). UpdatedAt is
+// stamped with the current time when the summary or links change.
 func (m *Manager) MergeContent(existing, newContent *WeeklyContent) *WeeklyContent {
 	if newContent == nil {
 		return existing
@@ -231,11 +493,15 @@ func (m *Manager) MergeContent(existing, newContent *WeeklyContent) *WeeklyConte
 	}
 
 	// Merge new proposals
+	var updated int
 	for _, newProposal := range newContent.Proposals {
 		if existingProposal, ok := proposalMap[newProposal.IssueNumber]; ok {
 			// Update existing proposal
 			merged := mergeProposal(existingProposal, newProposal)
+			m.stampEdit(existingProposal, &merged)
 			proposalMap[newProposal.IssueNumber] = merged
+			updated++
+			m.logger.Debug("merged proposal with existing content", "issue", newProposal.IssueNumber)
 		} else {
 			// Add new proposal
 			proposalMap[newProposal.IssueNumber] = newProposal
@@ -247,6 +513,11 @@ func (m *Manager) MergeContent(existing, newContent *WeeklyContent) *WeeklyConte
 	for _, p := range proposalMap {
 		proposals = append(proposals, p)
 	}
+	SortProposals(proposals)
+
+	m.logger.Info("merged with existing content",
+		"year", newContent.Year, "week", newContent.Week,
+		"existing", len(existing.Proposals), "updated", updated, "added", len(newContent.Proposals)-updated)
 
 	return &WeeklyContent{
 		Year:      newContent.Year,
@@ -267,87 +538,230 @@ func mergeProposal(existing, newProposal ProposalContent) ProposalContent {
 		CurrentStatus:  newProposal.CurrentStatus,
 		ChangedAt:      newProposal.ChangedAt,
 		CommentURL:     newProposal.CommentURL,
+		Reviewer:       newProposal.Reviewer,
+		Category:       newProposal.Category,
 		Summary:        newProposal.Summary,
-		Links:          mergeLinks(existing.Links, newProposal.Links),
+		Body:           newProposal.Body,
+		Links:          mergeLinks(newProposal.IssueNumber, existing.Links, newProposal.Links),
+		UpdatedAt:      newProposal.UpdatedAt,
+
+		SummaryLanguage: newProposal.SummaryLanguage,
+		SummaryEN:       newProposal.SummaryEN,
+		Transitions:     mergeTransitions(existing.Transitions, newProposal.Transitions),
+		History:         appendHistory(existing, newProposal),
 	}
 
-	// Preserve existing summary if new one is empty
-	if merged.Summary == "" && existing.Summary != "" {
+	// Keep the known reviewer if the new change does not name one
+	if merged.Reviewer == "" {
+		merged.Reviewer = existing.Reviewer
+	}
+	if merged.Category == "" {
+		merged.Category = existing.Category
+	}
+	if merged.SummaryEN == "" {
+		merged.SummaryEN = existing.SummaryEN
+	}
+
+	// Keep the latest known edit time
+	if existing.UpdatedAt.After(merged.UpdatedAt) {
+		merged.UpdatedAt = existing.UpdatedAt
+	}
+
+	// Preserve existing summary and body if new ones are empty
+	if merged.Summary == "" && merged.Body == "" && (existing.Summary != "" || existing.Body != "") {
 		merged.Summary = existing.Summary
+		merged.Body = existing.Body
+		merged.SummaryLanguage = existing.SummaryLanguage
 	}
 
 	return merged
 }
 
-// mergeLinks merges two link slices, deduplicating by URL.
-func mergeLinks(existing, newLinks []Link) []Link {
-	urlMap := make(map[string]Link)
+// stampEdit sets the UpdatedAt of after, a new version of the written
+// proposal before, to the current time when the text or related links
+// written for it differ from those of before.
+func (m *Manager) stampEdit(before ProposalContent, after *ProposalContent) {
+	if m.writtenText(before) == m.writtenText(*after) && slices.Equal(before.Links, after.Links) {
+		return
+	}
+	after.UpdatedAt = m.now().UTC()
+}
 
-	// Add existing links first
-	for _, link := range existing {
-		urlMap[link.URL] = link
+// writtenText returns the markdown that WriteContent writes before the
+// related links section of p.
+func (m *Manager) writtenText(p ProposalContent) string {
+	if p.Body != "" {
+		return p.Body
+	}
+	if m.maxSummaryLen > 0 {
+		return truncateSummary(p.Summary, m.maxSummaryLen)
 	}
+	return p.Summary
+}
 
-	// Add/update with new links
-	for _, link := range newLinks {
+// mergeLinks merges two link slices, deduplicating by URL. A link in
+// newLinks replaces an existing link with the same URL.
+// The result is ordered deterministically so that rewrites produce stable
+// markdown: the link to the proposal issue itself first, then the other
+// golang/go issue links sorted by URL, then the remaining links (e.g. those
+// extracted from summaries) in first-appearance order.
+func mergeLinks(issue int, existing, newLinks []Link) []Link {
+	urlMap := make(map[string]Link)
+	var order []string
+	for _, link := range slices.Concat(existing, newLinks) {
+		if _, ok := urlMap[link.URL]; !ok {
+			order = append(order, link.URL)
+		}
 		urlMap[link.URL] = link
 	}
 
-	// Convert back to slice
-	result := make([]Link, 0, len(urlMap))
-	for _, link := range urlMap {
-		result = append(result, link)
+	var own, related, others []Link
+	for _, linkURL := range order {
+		link := urlMap[linkURL]
+		m := golangIssueURLRe.FindStringSubmatch(linkURL)
+		switch {
+		case m == nil:
+			others = append(others, link)
+		case m[1] == strconv.Itoa(issue):
+			own = append(own, link)
+		default:
+			related = append(related, link)
+		}
 	}
+	slices.SortFunc(related, func(a, b Link) int {
+		return strings.Compare(a.URL, b.URL)
+	})
 
-	return result
+	result := make([]Link, 0, len(order))
+	result = append(result, own...)
+	result = append(result, related...)
+	return append(result, others...)
+}
+
+// readFS returns the file system that content and summaries are read from.
+func (m *Manager) readFS() fs.FS {
+	if m.fsys != nil {
+		return m.fsys
+	}
+	return osFS{}
 }
 
+// readPath joins path elements for use with readFS.
+func (m *Manager) readPath(elem ...string) string {
+	if m.fsys != nil {
+		return path.Join(elem...)
+	}
+	return filepath.Join(elem...)
+}
+
+// osFS is an fs.FS backed directly by the os package. Unlike os.DirFS it
+// accepts any OS path, so existing relative and absolute directories keep
+// working when no custom file system is configured.
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error)          { return os.Open(name) }
+func (osFS) ReadDir(name string) ([]fs.DirEntry, error) { return os.ReadDir(name) }
+func (osFS) ReadFile(name string) ([]byte, error)       { return os.ReadFile(name) }
+func (osFS) Stat(name string) (fs.FileInfo, error)      { return os.Stat(name) }
+
 // ReadExistingContent reads existing content for the given year and week.
 // Returns nil if no content exists for the specified week.
 func (m *Manager) ReadExistingContent(year, week int) (*WeeklyContent, error) {
-	dirPath := filepath.Join(m.baseDir, weekDirPath(year, week))
+	fsys := m.readFS()
+	dirPath := m.readPath(m.baseDir, m.weekDir(year, week))
 
 	// Check if directory exists
-	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+	if _, err := fs.Stat(fsys, dirPath); errors.Is(err, fs.ErrNotExist) {
 		return nil, nil
 	}
 
 	// Read all proposal files in the directory
-	entries, err := os.ReadDir(dirPath)
+	entries, err := fs.ReadDir(fsys, dirPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read directory %s: %w", dirPath, err)
 	}
 
 	proposals := make([]ProposalContent, 0)
+	// The merge logic assumes one file per issue, so a stray copy is an error
+	seen := make(map[int]string)
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "proposal-") || !strings.HasSuffix(entry.Name(), ".md") {
+		if !isProposalEntry(entry) {
 			continue
 		}
 
-		filePath := filepath.Join(dirPath, entry.Name())
-		proposal, err := parseProposalFile(filePath)
+		filePath := m.readPath(dirPath, entry.Name())
+		proposal, err := parseProposalFile(fsys, filePath, m.headings)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse proposal file %s: %w", filePath, err)
+			return nil, fmt.Errorf("failed to parse proposal file: %w", err)
 		}
 
+		if other, ok := seen[proposal.IssueNumber]; ok {
+			return nil, fmt.Errorf("duplicate proposal files for issue #%d: %s and %s", proposal.IssueNumber, other, filePath)
+		}
+		seen[proposal.IssueNumber] = filePath
+
 		proposals = append(proposals, *proposal)
 	}
 
 	if len(proposals) == 0 {
 		return nil, nil
 	}
+	SortProposals(proposals)
 
-	return &WeeklyContent{
+	wc := &WeeklyContent{
 		Year:      year,
 		Week:      week,
 		Proposals: proposals,
 		CreatedAt: time.Time{}, // Will be set from file if needed
-	}, nil
+	}
+	wc.MarkChangedThisWeek()
+	// Files written before categories were recorded have none
+	m.AssignCategories(wc)
+	return wc, nil
+}
+
+// WeekExists reports whether the given week has at least one proposal file.
+// Unlike ReadExistingContent, it does not parse the files.
+func (m *Manager) WeekExists(year, week int) (bool, error) {
+	fsys := m.readFS()
+	dirPath := m.readPath(m.baseDir, m.weekDir(year, week))
+
+	entries, err := fs.ReadDir(fsys, dirPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read directory %s: %w", dirPath, err)
+	}
+
+	for _, entry := range entries {
+		if isProposalEntry(entry) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isProposalEntry reports whether a directory entry is a proposal file.
+func isProposalEntry(entry fs.DirEntry) bool {
+	return !entry.IsDir() && strings.HasPrefix(entry.Name(), "proposal-") && strings.HasSuffix(entry.Name(), ".md")
+}
+
+// SortProposals sorts proposals by ChangedAt (oldest first).
+// Proposals sharing the same ChangedAt are ordered by IssueNumber so that
+// the result is deterministic regardless of the input order.
+func SortProposals(proposals []ProposalContent) {
+	sort.SliceStable(proposals, func(i, j int) bool {
+		if !proposals[i].ChangedAt.Equal(proposals[j].ChangedAt) {
+			return proposals[i].ChangedAt.Before(proposals[j].ChangedAt)
+		}
+		return proposals[i].IssueNumber < proposals[j].IssueNumber
+	})
 }
 
 // parseProposalFile parses a proposal markdown file and returns its content.
-func parseProposalFile(filePath string) (proposal *ProposalContent, err error) {
-	file, err := os.Open(filePath)
+func parseProposalFile(fsys fs.FS, filePath string, headings sectionHeadings) (proposal *ProposalContent, err error) {
+	file, err := fsys.Open(filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -358,80 +772,84 @@ func parseProposalFile(filePath string) (proposal *ProposalContent, err error) {
 	}()
 
 	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	// missingErr reports a required field absent from the whole file.
+	missingErr := func(field string) error {
+		return &ParseError{Path: filePath, Field: field, Err: errMissingField}
+	}
 	var p ProposalContent
 	var inFrontmatter bool
 	var inBody bool
 	var inSummarySection bool
+	var inSummaryENSection bool
+	var hasCommentURL bool
+	var frontmatterStart int
+	var frontmatterBuilder strings.Builder
 	var summaryBuilder strings.Builder
+	var summaryENBuilder strings.Builder
 	var fullContentBuilder strings.Builder
-	var currentLinkTitle string
-
-	issueRe := regexp.MustCompile(`^issue_number:\s*(\d+)`)
-	titleRe := regexp.MustCompile(`^title:\s*"(.+)"`)
-	prevStatusRe := regexp.MustCompile(`^previous_status:\s*(\w+)`)
-	currStatusRe := regexp.MustCompile(`^current_status:\s*(\w+)`)
-	changedAtRe := regexp.MustCompile(`^changed_at:\s*(.+)`)
-	commentURLRe := regexp.MustCompile(`^comment_url:\s*(.+)`)
-	linkTitleRe := regexp.MustCompile(`^\s*-\s*title:\s*"(.+)"`)
-	linkURLRe := regexp.MustCompile(`^\s*url:\s*(.+)`)
+	var bodyBuilder strings.Builder
+	summaryHeading := "## " + headings.summary
+	linksHeading := "## " + headings.links
 
 	for scanner.Scan() {
+		lineNum++
 		line := scanner.Text()
-		// Handle CRLF line endings (e.g., Windows files)
+		// Handle CRLF line endings and a leading BOM (e.g., Windows files)
 		line = strings.TrimSuffix(line, "\r")
+		if lineNum == 1 {
+			line = strings.TrimPrefix(line, utf8BOM)
+		}
 
-		if line == "---" {
+		if line == "---" && !inBody {
 			if !inFrontmatter {
 				inFrontmatter = true
-				continue
-			} else {
-				inFrontmatter = false
-				inBody = true
+				frontmatterStart = lineNum
 				continue
 			}
+			inFrontmatter = false
+			inBody = true
+			keys, decodeErr := decodeFrontmatter(frontmatterBuilder.String(), &p)
+			if decodeErr != nil {
+				decodeErr.Path = filePath
+				decodeErr.Line += frontmatterStart
+				return nil, decodeErr
+			}
+			hasCommentURL = slices.Contains(keys, "comment_url")
+			continue
 		}
 
 		if inFrontmatter {
-			if m := issueRe.FindStringSubmatch(line); m != nil {
-				issueNum, parseErr := strconv.Atoi(m[1])
-				if parseErr != nil {
-					return nil, fmt.Errorf("failed to parse issue_number: %w", parseErr)
-				}
-				p.IssueNumber = issueNum
-			} else if m := titleRe.FindStringSubmatch(line); m != nil {
-				p.Title = m[1]
-			} else if m := prevStatusRe.FindStringSubmatch(line); m != nil {
-				p.PreviousStatus = parser.Status(m[1])
-			} else if m := currStatusRe.FindStringSubmatch(line); m != nil {
-				p.CurrentStatus = parser.Status(m[1])
-			} else if m := changedAtRe.FindStringSubmatch(line); m != nil {
-				changedAt, parseErr := time.Parse(time.RFC3339, m[1])
-				if parseErr != nil {
-					return nil, fmt.Errorf("failed to parse changed_at: %w", parseErr)
-				}
-				p.ChangedAt = changedAt
-			} else if m := commentURLRe.FindStringSubmatch(line); m != nil {
-				p.CommentURL = m[1]
-			} else if m := linkTitleRe.FindStringSubmatch(line); m != nil {
-				currentLinkTitle = m[1]
-			} else if m := linkURLRe.FindStringSubmatch(line); m != nil {
-				if currentLinkTitle == "" {
-					return nil, fmt.Errorf("link URL found without preceding title: %s", m[1])
-				}
-				p.Links = append(p.Links, Link{
-					Title: currentLinkTitle,
-					URL:   m[1],
-				})
-				currentLinkTitle = ""
-			}
+			frontmatterBuilder.WriteString(line)
+			frontmatterBuilder.WriteString("\n")
 		} else if inBody {
 			// Stop when we hit the related links section
-			if strings.HasPrefix(line, "## 関連リンク") {
+			if strings.HasPrefix(line, linksHeading) {
 				break
 			}
 
-			// Track if we're in the summary (概要) section
-			if strings.HasPrefix(line, "## 概要") {
+			// Keep the raw body, including blank lines, for round-tripping
+			bodyBuilder.WriteString(line)
+			bodyBuilder.WriteString("\n")
+
+			// The English summary is kept apart from the full content, which
+			// holds the Japanese sections only
+			if line == "## "+SummaryENHeading {
+				inSummaryENSection = true
+				inSummarySection = false
+				continue
+			}
+			if inSummaryENSection {
+				if !strings.HasPrefix(line, "## ") {
+					summaryENBuilder.WriteString(line)
+					summaryENBuilder.WriteString("\n")
+					continue
+				}
+				inSummaryENSection = false
+			}
+
+			// Track if we're in the summary section
+			if strings.HasPrefix(line, summaryHeading) {
 				inSummarySection = true
 				// Add to full content
 				if fullContentBuilder.Len() > 0 {
@@ -446,7 +864,7 @@ func parseProposalFile(filePath string) (proposal *ProposalContent, err error) {
 				inSummarySection = false
 			}
 
-			// Collect lines for full content (everything from ## 要約 to ## 関連リンク)
+			// Collect lines for full content (everything up to the links section)
 			if strings.TrimSpace(line) != "" {
 				if fullContentBuilder.Len() > 0 {
 					fullContentBuilder.WriteString("\n")
@@ -454,7 +872,7 @@ func parseProposalFile(filePath string) (proposal *ProposalContent, err error) {
 				fullContentBuilder.WriteString(line)
 			}
 
-			// Collect lines for summary (only ## 概要 section)
+			// Collect lines for summary (only the summary section)
 			if inSummarySection && strings.TrimSpace(line) != "" {
 				if summaryBuilder.Len() > 0 {
 					summaryBuilder.WriteString("\n")
@@ -465,33 +883,81 @@ func parseProposalFile(filePath string) (proposal *ProposalContent, err error) {
 	}
 
 	p.Summary = strings.TrimSpace(summaryBuilder.String())
+	p.SummaryEN = strings.TrimSpace(summaryENBuilder.String())
 	p.FullContent = strings.TrimSpace(fullContentBuilder.String())
+	p.Body = strings.Trim(bodyBuilder.String(), "\n")
+	normalizeTimes(&p)
 
 	if scanErr := scanner.Err(); scanErr != nil {
-		return nil, scanErr
+		return nil, &ParseError{Path: filePath, Line: lineNum, Err: scanErr}
 	}
 
 	// Validate required fields
 	if p.IssueNumber == 0 {
-		return nil, fmt.Errorf("missing required field: issue_number")
+		return nil, missingErr("issue_number")
 	}
 	if p.Title == "" {
-		return nil, fmt.Errorf("missing required field: title")
+		return nil, missingErr("title")
 	}
 	// previous_status can be empty for new proposals
 	if p.CurrentStatus == "" {
-		return nil, fmt.Errorf("missing required field: current_status")
+		return nil, missingErr("current_status")
 	}
 	if p.ChangedAt.IsZero() {
-		return nil, fmt.Errorf("missing required field: changed_at")
+		return nil, missingErr("changed_at")
 	}
-	if p.CommentURL == "" {
-		return nil, fmt.Errorf("missing required field: comment_url")
+	// comment_url may be written empty, but the key itself is required
+	if !hasCommentURL {
+		return nil, missingErr("comment_url")
 	}
 
 	return &p, nil
 }
 
+// normalizeTimes converts the timestamps of p to UTC. Files are written in
+// UTC, but hand-edited ones may carry another offset (e.g. +09:00), which
+// would otherwise shift the ISO week computed from ChangedAt.
+func normalizeTimes(p *ProposalContent) {
+	p.ChangedAt = p.ChangedAt.UTC()
+	p.UpdatedAt = p.UpdatedAt.UTC()
+	for i := range p.Transitions {
+		p.Transitions[i].ChangedAt = p.Transitions[i].ChangedAt.UTC()
+	}
+	for i := range p.History {
+		p.History[i].ChangedAt = p.History[i].ChangedAt.UTC()
+	}
+}
+
+// decodeFrontmatter unmarshals the YAML frontmatter into p using the yaml
+// tags of ProposalContent and returns the top-level keys present. Each key is
+// decoded separately so that an error can be attributed to its field; the
+// Line of the returned error is relative to the start of the frontmatter.
+// Unknown keys are ignored.
+func decodeFrontmatter(frontmatter string, p *ProposalContent) ([]string, *ParseError) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(frontmatter), &doc); err != nil {
+		return nil, &ParseError{Err: fmt.Errorf("failed to parse frontmatter: %w", err)}
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, &ParseError{Line: root.Line, Err: errors.New("frontmatter is not a mapping")}
+	}
+	keys := make([]string, 0, len(root.Content)/2)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		pair := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{key, value}}
+		if err := pair.Decode(p); err != nil {
+			return nil, &ParseError{Line: key.Line, Field: key.Value, Err: err}
+		}
+		keys = append(keys, key.Value)
+	}
+	return keys, nil
+}
+
 // WriteContentWithMerge writes content, merging with any existing content for the same week.
 // Past week data is not modified.
 func (m *Manager) WriteContentWithMerge(content *WeeklyContent) error {
@@ -499,6 +965,15 @@ func (m *Manager) WriteContentWithMerge(content *WeeklyContent) error {
 		return nil
 	}
 
+	// Skip reading when the week has no content yet
+	exists, err := m.WeekExists(content.Year, content.Week)
+	if err != nil {
+		return fmt.Errorf("failed to check existing content: %w", err)
+	}
+	if !exists {
+		return m.WriteContent(content)
+	}
+
 	// Read existing content for the same week
 	existing, err := m.ReadExistingContent(content.Year, content.Week)
 	if err != nil {
@@ -513,8 +988,11 @@ func (m *Manager) WriteContentWithMerge(content *WeeklyContent) error {
 }
 
 // IntegrateSummaries integrates AI-generated summaries into the content.
-// It also extracts any GitHub issue links from the summaries and adds them to the Links.
+// It also extracts any GitHub issue links, and links to hosts allowed by
+// WithAllowedLinkHosts, from the summaries and adds them to the Links.
 // The "関連リンク" section is stripped from summaries to avoid duplication with the auto-generated section.
+// Proposals that already had a summary get UpdatedAt stamped when their text
+// or links change; MergeContent does the same against existing files.
 func (m *Manager) IntegrateSummaries(content *WeeklyContent, summaries map[int]string) error {
 	if content == nil {
 		return nil
@@ -524,25 +1002,62 @@ func (m *Manager) IntegrateSummaries(content *WeeklyContent, summaries map[int]s
 		issueNumber := content.Proposals[i].IssueNumber
 		summary, ok := summaries[issueNumber]
 		if !ok || summary == "" {
+			m.logger.Debug("no summary for proposal", "issue", issueNumber)
 			continue
 		}
+		before := content.Proposals[i]
 
 		// Extract links from the summary before stripping the section
-		extractedLinks := extractLinksFromMarkdown(summary)
-		content.Proposals[i].Links = mergeLinks(content.Proposals[i].Links, extractedLinks)
+		extractedLinks := extractLinksFromMarkdown(summary, m.linkHosts)
+		content.Proposals[i].Links = mergeLinks(issueNumber, content.Proposals[i].Links, extractedLinks)
 
 		// Strip the "関連リンク" section from the summary to avoid duplication
-		summary = stripRelatedLinksSection(summary)
+		summary = stripRelatedLinksSection(summary, m.headings.links)
+		if m.postProcess != nil {
+			summary = m.postProcess(issueNumber, summary)
+		}
 		content.Proposals[i].Summary = summary
+		// The new summary replaces any body read from an existing file
+		content.Proposals[i].Body = ""
+		// Replacing the text of content read back from files is an edit
+		if m.writtenText(before) != "" {
+			m.stampEdit(before, &content.Proposals[i])
+		}
+		m.logger.Info("summary integrated", "issue", issueNumber, "links", len(extractedLinks))
 	}
 
 	return nil
 }
 
-// stripRelatedLinksSection removes the "関連リンク" section from markdown text.
+// IntegrateSummariesWithReport integrates summaries like IntegrateSummaries
+// and additionally checks each integrated summary with ValidateSummaryLength.
+// Summaries failing the check are still integrated; the returned report maps
+// their issue numbers to the validation reason.
+func (m *Manager) IntegrateSummariesWithReport(content *WeeklyContent, summaries map[int]string) (map[int]string, error) {
+	if err := m.IntegrateSummaries(content, summaries); err != nil {
+		return nil, err
+	}
+
+	report := make(map[int]string)
+	if content == nil {
+		return report, nil
+	}
+	for _, p := range content.Proposals {
+		if summary, ok := summaries[p.IssueNumber]; !ok || summary == "" {
+			continue
+		}
+		if ok, reason := ValidateSummaryLength(p.Summary); !ok {
+			report[p.IssueNumber] = reason
+		}
+	}
+	return report, nil
+}
+
+// stripRelatedLinksSection removes the related links section with the given
+// heading from markdown text.
 // This prevents duplication since generateMarkdown adds its own related links section.
-func stripRelatedLinksSection(text string) string {
-	// Find the "## 関連リンク" header and remove everything from there to the end
+func stripRelatedLinksSection(text, heading string) string {
+	// Find the links heading and remove everything from there to the end
 	// or until the next ## header
 	lines := strings.Split(text, "\n")
 	var result []string
@@ -550,7 +1065,7 @@ func stripRelatedLinksSection(text string) string {
 
 	for _, line := range lines {
 		// Check if this is the start of the related links section
-		if strings.HasPrefix(line, "## 関連リンク") {
+		if strings.HasPrefix(line, "## "+heading) {
 			inRelatedLinks = true
 			continue
 		}
@@ -570,11 +1085,14 @@ func stripRelatedLinksSection(text string) string {
 
 // ApplyFallback applies fallback text to proposals that have no summary.
 // The fallback contains basic information: proposal number, title, and status change.
-func (m *Manager) ApplyFallback(content *WeeklyContent) error {
+// It returns the issue numbers of the proposals that received the fallback,
+// sorted in ascending order.
+func (m *Manager) ApplyFallback(content *WeeklyContent) ([]int, error) {
 	if content == nil {
-		return nil
+		return nil, nil
 	}
 
+	var applied []int
 	for i := range content.Proposals {
 		if content.Proposals[i].Summary != "" {
 			continue
@@ -582,76 +1100,354 @@ func (m *Manager) ApplyFallback(content *WeeklyContent) error {
 
 		p := content.Proposals[i]
 		content.Proposals[i].Summary = generateFallbackSummary(p)
+		// The fallback replaces any body read from an existing file
+		content.Proposals[i].Body = ""
+		applied = append(applied, p.IssueNumber)
+		m.logger.Info("fallback summary applied", "issue", p.IssueNumber)
 	}
+	slices.Sort(applied)
 
-	return nil
+	return applied, nil
 }
 
-// ReadSummaries reads all summary files from the summaries directory.
-// Returns a map of issue number to summary content.
+// ReadSummaries reads all summary files from the summaries directory,
+// including its subdirectories (see ReadLocalizedSummaries).
+// Returns a map of issue number to summary content. When summaries exist in
+// several languages, the one in the configured language is selected (see
+// WithSummaryLanguage and LocalizedSummary.Select).
 func (m *Manager) ReadSummaries() (map[int]string, error) {
-	summaries := make(map[int]string)
+	localized, err := m.ReadLocalizedSummaries()
+	if err != nil {
+		return nil, err
+	}
 
-	// Check if directory exists
-	if _, err := os.Stat(m.summariesDir); os.IsNotExist(err) {
-		return summaries, nil
+	lang, fallback := m.summaryLanguages()
+	summaries := make(map[int]string, len(localized))
+	for issue, ls := range localized {
+		if s, _, ok := ls.Select(lang, fallback); ok {
+			summaries[issue] = s
+		}
 	}
+	return summaries, nil
+}
 
-	entries, err := os.ReadDir(m.summariesDir)
+// summaryFileRe matches summary filenames with an optional language suffix,
+// e.g. "12345.md", "12345.txt" or "12345.en.md".
+var summaryFileRe = regexp.MustCompile(`^(\d+)(?:\.([A-Za-z]{2,3}(?:-[A-Za-z0-9]+)*))?\.(?:md|txt)$`)
+
+// ReadLocalizedSummaries reads all summary files from the summaries directory
+// and its subdirectories (e.g. summaries/2026/W05/12345.md), keyed by issue
+// number and language. Both .md and .txt files are read. Files without a
+// language suffix are treated as the default summary language; a suffixed
+// file for the same language takes precedence. If several files exist for
+// the same issue and language, the lexicographically latest path is used and
+// a warning is logged.
+func (m *Manager) ReadLocalizedSummaries() (map[int]LocalizedSummary, error) {
+	sources, err := m.readSummarySources()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read summaries directory %s: %w", m.summariesDir, err)
+		return nil, err
 	}
 
-	summaryFileRe := regexp.MustCompile(`^(\d+)\.md$`)
+	summaries := make(map[int]LocalizedSummary, len(sources))
+	for issue, src := range sources {
+		summaries[issue] = localized(src)
+	}
+	return summaries, nil
+}
 
-	for _, entry := range entries {
+// readSummarySources reads all summary files as described for
+// ReadLocalizedSummaries, keyed by issue number and language.
+func (m *Manager) readSummarySources() (map[int]map[string]summarySource, error) {
+	summaries := make(map[int]map[string]summarySource)
+	fsys := m.readFS()
+
+	// Check if directory exists
+	if _, err := fs.Stat(fsys, m.summariesDir); errors.Is(err, fs.ErrNotExist) {
+		return summaries, nil
+	}
+
+	_, defaultLang := m.summaryLanguages()
+
+	type summaryKey struct {
+		language    string
+		issueNumber int
+	}
+	found := make(map[summaryKey]summaryFile)
+	err := fs.WalkDir(fsys, m.summariesDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
 		if entry.IsDir() {
-			continue
+			return nil
 		}
 
 		matches := summaryFileRe.FindStringSubmatch(entry.Name())
 		if matches == nil {
-			continue
+			return nil
 		}
 
 		issueNumber, err := strconv.Atoi(matches[1])
 		if err != nil {
+			return nil
+		}
+
+		f := summaryFile{
+			issueNumber: issueNumber,
+			language:    matches[2],
+			path:        filePath,
+		}
+		key := summaryKey{language: f.language, issueNumber: issueNumber}
+		if prev, ok := found[key]; ok {
+			kept, dropped := prev, f
+			if f.path > prev.path {
+				kept, dropped = f, prev
+			}
+			m.logger.Warn("duplicate summary files, using the latest path",
+				"issue", issueNumber, "used", kept.path, "ignored", dropped.path)
+			f = kept
+		}
+		found[key] = f
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read summaries directory %s: %w", m.summariesDir, err)
+	}
+
+	files := make([]summaryFile, 0, len(found))
+	for _, f := range found {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+
+	contents, err := readSummaryFiles(fsys, files, m.readConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, f := range files {
+		sources := summaries[f.issueNumber]
+		if sources == nil {
+			sources = make(map[string]summarySource)
+			summaries[f.issueNumber] = sources
+		}
+		src := summarySource{file: f, content: contents[i]}
+		if f.language != "" {
+			sources[f.language] = src
+		} else if _, ok := sources[defaultLang]; !ok {
+			sources[defaultLang] = src
+		}
+
+		if m.checkIssues {
+			if referenced, ok := mismatchedIssue(f.issueNumber, contents[i]); ok {
+				m.logger.Warn("summary mostly references a different issue",
+					"path", f.path, "issue", f.issueNumber, "referenced", referenced)
+			}
+		}
+	}
+
+	return summaries, nil
+}
+
+// summarySource is a summary read from a file.
+type summarySource struct {
+	content string
+	file    summaryFile
+}
+
+// localized returns the summaries of each language.
+func localized(sources map[string]summarySource) LocalizedSummary {
+	ls := make(LocalizedSummary, len(sources))
+	for lang, src := range sources {
+		ls[lang] = src.content
+	}
+	return ls
+}
+
+// SummaryMeta is a summary together with metadata about its source file.
+type SummaryMeta struct {
+	ModTime  time.Time
+	Content  string
+	Path     string
+	Language string
+	Size     int64 // Size of the file in bytes, before trimming
+}
+
+// ReadSummariesWithMeta reads summaries like ReadSummaries, additionally
+// returning the path, size and modification time of the file each summary
+// was read from, e.g. to detect stale summaries or report coverage.
+func (m *Manager) ReadSummariesWithMeta() (map[int]SummaryMeta, error) {
+	summaries, err := m.readSummarySources()
+	if err != nil {
+		return nil, err
+	}
+
+	fsys := m.readFS()
+	lang, fallback := m.summaryLanguages()
+	metas := make(map[int]SummaryMeta, len(summaries))
+	for issue, sources := range summaries {
+		content, selected, ok := localized(sources).Select(lang, fallback)
+		if !ok {
 			continue
 		}
+		src := sources[selected]
+		info, err := fs.Stat(fsys, src.file.path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat summary file %s: %w", src.file.path, err)
+		}
+		metas[issue] = SummaryMeta{
+			Content:  content,
+			Language: selected,
+			Path:     src.file.path,
+			Size:     info.Size(),
+			ModTime:  info.ModTime(),
+		}
+	}
+	return metas, nil
+}
+
+// issueRefRe matches issue references such as "#12345" and ".../issues/12345".
+var issueRefRe = regexp.MustCompile(`(?:#|/issues/)(\d+)\b`)
 
-		filePath := filepath.Join(m.summariesDir, entry.Name())
-		data, err := os.ReadFile(filePath)
+// mismatchedIssue reports the most-referenced issue number in summary if it
+// differs from issue. Summaries without references, or where issue is among
+// the most-referenced, are considered consistent.
+func mismatchedIssue(issue int, summary string) (int, bool) {
+	counts := make(map[int]int)
+	for _, m := range issueRefRe.FindAllStringSubmatch(summary, -1) {
+		n, err := strconv.Atoi(m[1])
 		if err != nil {
-			return nil, fmt.Errorf("failed to read summary file %s: %w", filePath, err)
+			continue
 		}
+		counts[n]++
+	}
 
-		summaries[issueNumber] = strings.TrimSpace(string(data))
+	top, topCount := 0, 0
+	for n, c := range counts {
+		if c > topCount || (c == topCount && n < top) {
+			top, topCount = n, c
+		}
 	}
+	if topCount == 0 || counts[issue] == topCount {
+		return 0, false
+	}
+	return top, true
+}
 
-	return summaries, nil
+// summaryFile identifies a summary file and the issue number it belongs to.
+type summaryFile struct {
+	path        string
+	language    string // Empty for files without a language suffix
+	issueNumber int
 }
 
+// utf8BOM is the byte order mark some Windows editors prepend to UTF-8 files.
+const utf8BOM = "\ufeff"
+
+// normalizeSummary strips a leading UTF-8 BOM, converts CRLF line endings to
+// LF and trims surrounding whitespace.
+func normalizeSummary(data []byte) string {
+	text := strings.TrimPrefix(string(data), utf8BOM)
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.TrimSpace(text)
+}
+
+// readSummaryFiles reads the given summary files using at most concurrency
+// goroutines. The returned contents are normalized by normalizeSummary and
+// indexed like files, so the result does not depend on the order in which
+// reads complete.
+func readSummaryFiles(fsys fs.FS, files []summaryFile, concurrency int) ([]string, error) {
+	contents := make([]string, len(files))
+	errs := make([]error, len(files))
+
+	readOne := func(i int) {
+		data, err := fs.ReadFile(fsys, files[i].path)
+		if err != nil {
+			errs[i] = fmt.Errorf("failed to read summary file %s: %w", files[i].path, err)
+			return
+		}
+		contents[i] = normalizeSummary(data)
+	}
+
+	if concurrency < 2 {
+		for i := range files {
+			readOne(i)
+			if errs[i] != nil {
+				return nil, errs[i]
+			}
+		}
+		return contents, nil
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			readOne(i)
+		}()
+	}
+	wg.Wait()
+
+	// Report the first failure in file order for deterministic errors
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return contents, nil
+}
+
+// markdownLinkRe matches inline markdown links with absolute http(s) URLs.
+var markdownLinkRe = regexp.MustCompile(`\[([^\]]+)\]\((https?://[^)\s]+)\)`)
+
+// golangIssueLinkRe matches golang/go issue URLs with optional
+// #issuecomment-NNNN anchors, which are always extracted.
+var golangIssueLinkRe = regexp.MustCompile(`^https://github\.com/golang/go/issues/\d+(?:#issuecomment-\d+)?$`)
+
 // extractLinksFromMarkdown extracts markdown links from text.
-// It looks for patterns like [text](url) and returns them as Links.
-// Supports GitHub issue URLs with optional #issuecomment anchors.
-func extractLinksFromMarkdown(text string) []Link {
-	// Match GitHub issue URLs with optional #issuecomment-NNNN anchors
-	linkRe := regexp.MustCompile(`\[([^\]]+)\]\((https://github\.com/golang/go/issues/\d+(?:#issuecomment-\d+)?)\)`)
-	matches := linkRe.FindAllStringSubmatch(text, -1)
+// It looks for patterns like [text](url) and returns them as Links titled by
+// the link text. GitHub issue URLs (with optional #issuecomment anchors) are
+// always extracted; other links only if their host matches one of the
+// path.Match patterns in allowedHosts.
+func extractLinksFromMarkdown(text string, allowedHosts []string) []Link {
+	matches := markdownLinkRe.FindAllStringSubmatch(text, -1)
 
 	links := make([]Link, 0, len(matches))
 	for _, match := range matches {
-		if len(match) >= regexMatchMinGroups {
-			links = append(links, Link{
-				Title: match[1],
-				URL:   match[2],
-			})
+		if len(match) < regexMatchMinGroups {
+			continue
 		}
+		if !golangIssueLinkRe.MatchString(match[2]) && !hostAllowed(match[2], allowedHosts) {
+			continue
+		}
+		links = append(links, Link{
+			Title: match[1],
+			URL:   match[2],
+		})
 	}
 
 	return links
 }
 
+// hostAllowed reports whether the host of rawURL matches one of patterns.
+func hostAllowed(rawURL string, patterns []string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), host); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // generateFallbackSummary generates a fallback summary when AI summary is not available.
 func generateFallbackSummary(p ProposalContent) string {
 	if p.PreviousStatus == "" {
@@ -695,85 +1491,115 @@ func ValidateSummaryLength(summary string) (bool, string) {
 	return true, ""
 }
 
+// summaryEllipsis is appended to summaries truncated by truncateSummary.
+const summaryEllipsis = "…"
+
+// truncateSummary truncates summary to at most maxRunes runes, cutting at a
+// rune boundary so multibyte characters are never split. If truncation
+// occurs, the result ends with summaryEllipsis.
+func truncateSummary(summary string, maxRunes int) string {
+	if utf8.RuneCountInString(summary) <= maxRunes {
+		return summary
+	}
+	runes := []rune(summary)
+	return strings.TrimRightFunc(string(runes[:maxRunes-1]), unicode.IsSpace) + summaryEllipsis
+}
+
+// weekKey identifies a week directory (content/YYYY/WXX/ by default).
+type weekKey struct {
+	year int
+	week int
+}
+
 // ListAllWeeks scans the content directory and returns all available weekly contents.
-// It reads the directory structure (content/YYYY/WXX/) and parses all proposal files.
+// It reads the week directories of the configured layout (content/YYYY/WXX/
+// by default) and parses all proposal files.
 // Returns a slice of WeeklyContent sorted by date (newest first).
 func (m *Manager) ListAllWeeks() ([]*WeeklyContent, error) {
-	// Check if base directory exists
-	if _, err := os.Stat(m.baseDir); os.IsNotExist(err) {
-		return nil, nil
-	}
-
-	// Read year directories
-	yearEntries, err := os.ReadDir(m.baseDir)
+	keys, err := m.listWeekDirs()
 	if err != nil {
-		return nil, fmt.Errorf("failed to read base directory %s: %w", m.baseDir, err)
+		return nil, err
 	}
 
-	yearRe := regexp.MustCompile(`^(\d{4})$`)
-	weekRe := regexp.MustCompile(`^W(\d{2})$`)
-
 	var weeks []*WeeklyContent
-
-	for _, yearEntry := range yearEntries {
-		if !yearEntry.IsDir() {
-			continue
+	for _, key := range keys {
+		// Read the weekly content
+		content, err := m.ReadExistingContent(key.year, key.week)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read content for %d-W%02d: %w", key.year, key.week, err)
 		}
-
-		// Parse year from directory name
-		yearMatches := yearRe.FindStringSubmatch(yearEntry.Name())
-		if yearMatches == nil {
+		if content == nil {
 			continue
 		}
 
-		year, err := strconv.Atoi(yearMatches[1])
-		if err != nil {
-			continue
-		}
+		weeks = append(weeks, content)
+	}
 
-		// Read week directories for this year
-		yearPath := filepath.Join(m.baseDir, yearEntry.Name())
-		weekEntries, err := os.ReadDir(yearPath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to read year directory %s: %w", yearPath, err)
+	// Sort by date (newest first)
+	sort.Slice(weeks, func(i, j int) bool {
+		if weeks[i].Year != weeks[j].Year {
+			return weeks[i].Year > weeks[j].Year
 		}
+		return weeks[i].Week > weeks[j].Week
+	})
 
-		for _, weekEntry := range weekEntries {
-			if !weekEntry.IsDir() {
-				continue
-			}
-
-			// Parse week from directory name
-			weekMatches := weekRe.FindStringSubmatch(weekEntry.Name())
-			if weekMatches == nil {
-				continue
-			}
+	return weeks, nil
+}
 
-			week, err := strconv.Atoi(weekMatches[1])
-			if err != nil {
-				continue
-			}
+// golangIssueURLRe matches a golang/go issue URL as generated by PrepareContent.
+var golangIssueURLRe = regexp.MustCompile(`^https://github\.com/golang/go/issues/(\d+)$`)
 
-			// Read the weekly content
-			content, err := m.ReadExistingContent(year, week)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read content for %d-W%02d: %w", year, week, err)
-			}
-			if content == nil {
-				continue
-			}
+// ReconstructChanges rebuilds the proposal changes from the existing content
+// tree, e.g. when changes.json has been lost. Each proposal of every week is
+// projected back into a ProposalChange; related issues are recovered from the
+// golang/go issue links other than the proposal's own issue.
+// Returns changes sorted by ChangedAt (oldest first), then by issue number.
+func (m *Manager) ReconstructChanges() ([]parser.ProposalChange, error) {
+	weeks, err := m.ListAllWeeks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list weeks: %w", err)
+	}
 
-			weeks = append(weeks, content)
+	var changes []parser.ProposalChange
+	for _, week := range weeks {
+		for _, p := range week.Proposals {
+			changes = append(changes, parser.ProposalChange{
+				IssueNumber:    p.IssueNumber,
+				Title:          p.Title,
+				PreviousStatus: p.PreviousStatus,
+				CurrentStatus:  p.CurrentStatus,
+				ChangedAt:      p.ChangedAt,
+				CommentURL:     p.CommentURL,
+				Reviewer:       p.Reviewer,
+				RelatedIssues:  relatedIssuesFromLinks(p.IssueNumber, p.Links),
+			})
 		}
 	}
 
-	// Sort by date (newest first)
-	sort.Slice(weeks, func(i, j int) bool {
-		if weeks[i].Year != weeks[j].Year {
-			return weeks[i].Year > weeks[j].Year
+	sort.Slice(changes, func(i, j int) bool {
+		if !changes[i].ChangedAt.Equal(changes[j].ChangedAt) {
+			return changes[i].ChangedAt.Before(changes[j].ChangedAt)
 		}
-		return weeks[i].Week > weeks[j].Week
+		return changes[i].IssueNumber < changes[j].IssueNumber
 	})
 
-	return weeks, nil
+	return changes, nil
+}
+
+// relatedIssuesFromLinks extracts the golang/go issue numbers referenced by
+// links, excluding the proposal's own issue. Duplicates are dropped.
+func relatedIssuesFromLinks(issue int, links []Link) []int {
+	var related []int
+	for _, link := range links {
+		matches := golangIssueURLRe.FindStringSubmatch(link.URL)
+		if matches == nil {
+			continue
+		}
+		n, err := strconv.Atoi(matches[1])
+		if err != nil || n == issue || slices.Contains(related, n) {
+			continue
+		}
+		related = append(related, n)
+	}
+	return related
 }
@@ -2,11 +2,21 @@
 package content
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
+	"unicode/utf8"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
 )
@@ -751,6 +761,618 @@ func TestManager_WriteContentWithMerge(t *testing.T) {
 	}
 }
 
+func TestManager_UpdatedAtRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 3, 9, 30, 0, 0, time.UTC)
+
+	mgr := NewManager(WithBaseDir(t.TempDir()))
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{IssueNumber: 1, Title: "proposal: edited", CurrentStatus: parser.StatusActive, ChangedAt: changedAt, UpdatedAt: editedAt},
+			{IssueNumber: 2, Title: "proposal: untouched", CurrentStatus: parser.StatusActive, ChangedAt: changedAt},
+		},
+	}
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if !got.Proposals[0].UpdatedAt.Equal(editedAt) {
+		t.Errorf("UpdatedAt = %v, want %v", got.Proposals[0].UpdatedAt, editedAt)
+	}
+	if !got.Proposals[0].LastModified().Equal(editedAt) {
+		t.Errorf("LastModified() = %v, want %v", got.Proposals[0].LastModified(), editedAt)
+	}
+	if !got.Proposals[1].UpdatedAt.IsZero() {
+		t.Errorf("UpdatedAt = %v, want zero", got.Proposals[1].UpdatedAt)
+	}
+	if !got.Proposals[1].LastModified().Equal(changedAt) {
+		t.Errorf("LastModified() = %v, want %v", got.Proposals[1].LastModified(), changedAt)
+	}
+}
+
+func TestManager_UpdatedAtStampedOnEdit(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 3, 9, 30, 0, 0, time.UTC)
+	reeditedAt := time.Date(2026, 2, 10, 18, 0, 0, 0, time.UTC)
+
+	now := changedAt
+	mgr := NewManager(
+		WithBaseDir(t.TempDir()),
+		WithClock(func() time.Time { return now }),
+	)
+	changes := []parser.ProposalChange{{
+		IssueNumber:    12345,
+		Title:          "proposal: edited later",
+		PreviousStatus: parser.StatusActive,
+		CurrentStatus:  parser.StatusLikelyAccept,
+		ChangedAt:      changedAt,
+		CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+	}}
+	readBack := func() ProposalContent {
+		t.Helper()
+		wc, err := mgr.ReadExistingContent(2026, 5)
+		if err != nil {
+			t.Fatalf("ReadExistingContent() error = %v", err)
+		}
+		return wc.Proposals[0]
+	}
+
+	// Status change without a summary yet: the fallback is not an edit
+	wc := mgr.PrepareContent(changes)
+	if _, err := mgr.ApplyFallback(wc); err != nil {
+		t.Fatalf("ApplyFallback() error = %v", err)
+	}
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+	if got := readBack().UpdatedAt; !got.IsZero() {
+		t.Fatalf("UpdatedAt after status change = %v, want zero", got)
+	}
+
+	// The summary arrives later and is merged into the existing file
+	now = editedAt
+	wc = mgr.PrepareContent(changes)
+	if err := mgr.IntegrateSummaries(wc, map[int]string{12345: "## 概要\n\n要約です。"}); err != nil {
+		t.Fatalf("IntegrateSummaries() error = %v", err)
+	}
+	if err := mgr.WriteContentWithMerge(wc); err != nil {
+		t.Fatalf("WriteContentWithMerge() error = %v", err)
+	}
+	if got := readBack(); !got.UpdatedAt.Equal(editedAt) || !got.LastModified().Equal(editedAt) {
+		t.Errorf("UpdatedAt, LastModified() after summary = %v, %v, want %v", got.UpdatedAt, got.LastModified(), editedAt)
+	}
+
+	// Integrating the same summary into the read content is not an edit
+	now = reeditedAt
+	existing, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if err := mgr.IntegrateSummaries(existing, map[int]string{12345: "## 概要\n\n要約です。"}); err != nil {
+		t.Fatalf("IntegrateSummaries() error = %v", err)
+	}
+	if err := mgr.WriteContent(existing); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+	if got := readBack().UpdatedAt; !got.Equal(editedAt) {
+		t.Errorf("UpdatedAt after unchanged summary = %v, want %v", got, editedAt)
+	}
+
+	// Editing the summary and its links stamps the new time
+	if err := mgr.IntegrateSummaries(existing, map[int]string{12345: "## 概要\n\n#67890 を踏まえた要約です。"}); err != nil {
+		t.Fatalf("IntegrateSummaries() error = %v", err)
+	}
+	if err := mgr.WriteContent(existing); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+	if got := readBack().UpdatedAt; !got.Equal(reeditedAt) {
+		t.Errorf("UpdatedAt after edited summary = %v, want %v", got, reeditedAt)
+	}
+}
+
+func TestManager_BodyRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	body := "## 概要\n\nAdds a new API.\n\n## 背景\n\nLong-standing request.\n\n## メモ\n\n- Extra prose kept as is\n\n  indented line"
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{
+				IssueNumber:   1,
+				Title:         "proposal: rich body",
+				CurrentStatus: parser.StatusAccepted,
+				ChangedAt:     changedAt,
+				CommentURL:    "https://github.com/golang/go/issues/33502#issuecomment-1",
+				Summary:       body,
+				Links:         []Link{{Title: "issue", URL: "https://github.com/golang/go/issues/1"}},
+			},
+		},
+	}
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+	filePath := filepath.Join(baseDir, weekDirPath(2026, 5), "proposal-1.md")
+	want, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if got.Proposals[0].Body != body {
+		t.Errorf("Body = %q, want %q", got.Proposals[0].Body, body)
+	}
+	if got.Proposals[0].Summary != "Adds a new API." {
+		t.Errorf("Summary = %q, want only the ## 概要 section", got.Proposals[0].Summary)
+	}
+
+	// Writing back what was read must not change the file
+	if err := mgr.WriteContent(got); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+	rewritten, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if string(rewritten) != string(want) {
+		t.Errorf("round trip changed the file:\ngot:\n%s\nwant:\n%s", rewritten, want)
+	}
+
+	// A merge without a new summary keeps the body
+	merged := mgr.PrepareContent([]parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: rich body", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt, CommentURL: "https://github.com/golang/go/issues/33502#issuecomment-1"},
+	})
+	if err := mgr.WriteContentWithMerge(merged); err != nil {
+		t.Fatalf("WriteContentWithMerge() error = %v", err)
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if !strings.Contains(string(data), "## メモ\n\n- Extra prose kept as is\n\n  indented line\n") {
+		t.Errorf("merge dropped the extra section:\n%s", data)
+	}
+}
+
+func TestManager_WriteContentWithMerge_History(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+	base := time.Date(2026, 1, 26, 12, 0, 0, 0, time.UTC)
+
+	sequence := []parser.Status{parser.StatusDiscussions, parser.StatusLikelyAccept, parser.StatusAccepted}
+	previous := parser.Status("")
+	for i, status := range sequence {
+		wc := mgr.PrepareContent([]parser.ProposalChange{{
+			IssueNumber:    1,
+			Title:          "proposal: history",
+			PreviousStatus: previous,
+			CurrentStatus:  status,
+			ChangedAt:      base.Add(time.Duration(i) * 24 * time.Hour),
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+		}})
+		if err := mgr.WriteContentWithMerge(wc); err != nil {
+			t.Fatalf("WriteContentWithMerge(%s) error = %v", status, err)
+		}
+		previous = status
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	history := got.Proposals[0].History
+	if len(history) != len(sequence) {
+		t.Fatalf("got %d history entries, want %d: %+v", len(history), len(sequence), history)
+	}
+	for i, status := range sequence {
+		if history[i].Status != status {
+			t.Errorf("history[%d].Status = %s, want %s", i, history[i].Status, status)
+		}
+		if want := base.Add(time.Duration(i) * 24 * time.Hour); !history[i].ChangedAt.Equal(want) {
+			t.Errorf("history[%d].ChangedAt = %v, want %v", i, history[i].ChangedAt, want)
+		}
+	}
+
+	// Merging the latest status again does not grow the history
+	wc := mgr.PrepareContent([]parser.ProposalChange{{
+		IssueNumber:    1,
+		Title:          "proposal: history",
+		PreviousStatus: parser.StatusLikelyAccept,
+		CurrentStatus:  parser.StatusAccepted,
+		ChangedAt:      base.Add(2 * 24 * time.Hour),
+		CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+	}})
+	if err := mgr.WriteContentWithMerge(wc); err != nil {
+		t.Fatalf("WriteContentWithMerge() error = %v", err)
+	}
+	got, err = mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if len(got.Proposals[0].History) != len(sequence) {
+		t.Errorf("got %d history entries after re-merge, want %d", len(got.Proposals[0].History), len(sequence))
+	}
+}
+
+func TestManager_ReadExistingContent_ChangedThisWeek(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewManager(WithBaseDir(t.TempDir()))
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			// Changed in W04 and carried over into W05
+			{IssueNumber: 1, Title: "proposal: carried over", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 22, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/1"},
+			{IssueNumber: 2, Title: "proposal: new", CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/2"},
+		},
+	}
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	want := map[int]bool{1: false, 2: true}
+	for _, p := range got.Proposals {
+		if p.ChangedThisWeek != want[p.IssueNumber] {
+			t.Errorf("#%d ChangedThisWeek = %v, want %v", p.IssueNumber, p.ChangedThisWeek, want[p.IssueNumber])
+		}
+	}
+}
+
+func TestManager_SectionHeadings(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir), WithSummaryHeading("Summary"), WithLinksHeading("Related Links"))
+
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{
+				IssueNumber:   1,
+				Title:         "proposal: english",
+				CurrentStatus: parser.StatusAccepted,
+				ChangedAt:     changedAt,
+				CommentURL:    "https://github.com/golang/go/issues/33502#issuecomment-1",
+			},
+		},
+	}
+	summaries := map[int]string{
+		1: "## Summary\n\nAdds a new API.\n\n## Background\n\nLong-standing request.\n\n## Related Links\n\n- [Issue](https://github.com/golang/go/issues/1)",
+	}
+	if err := mgr.IntegrateSummaries(wc, summaries); err != nil {
+		t.Fatalf("IntegrateSummaries() error = %v", err)
+	}
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(baseDir, weekDirPath(2026, 5), "proposal-1.md"))
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if strings.Contains(string(data), DefaultLinksHeading) {
+		t.Errorf("file should not contain the Japanese links heading:\n%s", data)
+	}
+	if n := strings.Count(string(data), "## Related Links"); n != 1 {
+		t.Errorf("file contains %d links headings, want 1:\n%s", n, data)
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	p := got.Proposals[0]
+	if p.Summary != "Adds a new API." {
+		t.Errorf("Summary = %q, want %q", p.Summary, "Adds a new API.")
+	}
+	if strings.Contains(p.FullContent, "Related Links") {
+		t.Errorf("FullContent should stop at the links heading, got %q", p.FullContent)
+	}
+	if len(p.Links) != 1 || p.Links[0].URL != "https://github.com/golang/go/issues/1" {
+		t.Errorf("Links = %+v, want the extracted issue link", p.Links)
+	}
+
+	// The default headings do not recognize the English summary section
+	def, err := NewManager(WithBaseDir(baseDir)).ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if def.Proposals[0].Summary != "" {
+		t.Errorf("Summary with default headings = %q, want empty", def.Proposals[0].Summary)
+	}
+}
+
+func TestManager_ReviewerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+
+	wc := mgr.PrepareContent([]parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: reviewed", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt, CommentURL: "https://github.com/golang/go/issues/33502#issuecomment-1", Reviewer: "rsc"},
+		{IssueNumber: 2, Title: "proposal: anonymous", CurrentStatus: parser.StatusActive, ChangedAt: changedAt, CommentURL: "https://github.com/golang/go/issues/33502#issuecomment-1"},
+	})
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(baseDir, weekDirPath(wc.Year, wc.Week), "proposal-2.md"))
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if strings.Contains(string(data), "reviewer:") {
+		t.Errorf("frontmatter contains reviewer for unknown reviewer:\n%s", data)
+	}
+
+	// A later change without a reviewer keeps the recorded one
+	merged := mgr.PrepareContent([]parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: reviewed", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt, CommentURL: "https://github.com/golang/go/issues/33502#issuecomment-1"},
+	})
+	if err := mgr.WriteContentWithMerge(merged); err != nil {
+		t.Fatalf("WriteContentWithMerge() error = %v", err)
+	}
+
+	got, err := mgr.ReadExistingContent(wc.Year, wc.Week)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	reviewers := make(map[int]string)
+	for _, p := range got.Proposals {
+		reviewers[p.IssueNumber] = p.Reviewer
+	}
+	if reviewers[1] != "rsc" {
+		t.Errorf("Reviewer of #1 = %q, want %q", reviewers[1], "rsc")
+	}
+	if reviewers[2] != "" {
+		t.Errorf("Reviewer of #2 = %q, want empty", reviewers[2])
+	}
+}
+
+func TestManager_WriteContent_LineEnding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		lineEnding LineEnding
+		wantCRLF   bool
+	}{
+		{name: "defaults to LF", wantCRLF: false},
+		{name: "LF", lineEnding: LineEndingLF, wantCRLF: false},
+		{name: "CRLF", lineEnding: LineEndingCRLF, wantCRLF: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseDir := t.TempDir()
+			opts := []Option{WithBaseDir(baseDir)}
+			if tt.lineEnding != "" {
+				opts = append(opts, WithLineEnding(tt.lineEnding))
+			}
+			mgr := NewManager(opts...)
+
+			changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+			wc := &WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []ProposalContent{
+					{
+						IssueNumber:   12345,
+						Title:         "proposal: line endings",
+						CurrentStatus: parser.StatusActive,
+						ChangedAt:     changedAt,
+						Summary:       "## 概要\r\n\nmixed\r\nline endings\n",
+						Links:         []Link{{Title: "proposal issue", URL: "https://github.com/golang/go/issues/12345"}},
+					},
+				},
+			}
+			if err := mgr.WriteContent(wc); err != nil {
+				t.Fatalf("WriteContent() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(baseDir, "2026", "W05", "proposal-12345.md"))
+			if err != nil {
+				t.Fatalf("failed to read written file: %v", err)
+			}
+
+			lines := strings.Count(string(data), "\n")
+			crlf := strings.Count(string(data), "\r\n")
+			if tt.wantCRLF && crlf != lines {
+				t.Errorf("%d of %d lines end with CRLF, want all", crlf, lines)
+			}
+			if !tt.wantCRLF && crlf != 0 {
+				t.Errorf("%d lines end with CRLF, want none", crlf)
+			}
+			if strings.Contains(string(data), "\r\r") {
+				t.Error("file contains doubled carriage returns")
+			}
+
+			got, err := mgr.ReadExistingContent(2026, 5)
+			if err != nil {
+				t.Fatalf("ReadExistingContent() error = %v", err)
+			}
+			if len(got.Proposals) != 1 || got.Proposals[0].Title != "proposal: line endings" {
+				t.Errorf("ReadExistingContent() = %+v, want the written proposal", got.Proposals)
+			}
+		})
+	}
+}
+
+func TestManager_WeekExists(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		setup func(t *testing.T, baseDir string)
+		name  string
+		want  bool
+	}{
+		{
+			name: "present",
+			setup: func(t *testing.T, baseDir string) {
+				t.Helper()
+				mgr := NewManager(WithBaseDir(baseDir))
+				if err := mgr.WriteContent(&WeeklyContent{
+					Year: 2026,
+					Week: 5,
+					Proposals: []ProposalContent{
+						{IssueNumber: 12345, Title: "proposal: exists", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+					},
+				}); err != nil {
+					t.Fatalf("WriteContent() error = %v", err)
+				}
+			},
+			want: true,
+		},
+		{
+			name: "empty directory",
+			setup: func(t *testing.T, baseDir string) {
+				t.Helper()
+				dir := filepath.Join(baseDir, "2026", "W05")
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a proposal"), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: false,
+		},
+		{
+			name:  "absent",
+			setup: func(t *testing.T, baseDir string) { t.Helper() },
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseDir := t.TempDir()
+			tt.setup(t, baseDir)
+
+			got, err := NewManager(WithBaseDir(baseDir)).WeekExists(2026, 5)
+			if err != nil {
+				t.Fatalf("WeekExists() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("WeekExists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManager_ReconstructChanges(t *testing.T) {
+	t.Parallel()
+
+	original := []parser.ProposalChange{
+		{
+			IssueNumber:    11111,
+			Title:          "proposal: first",
+			PreviousStatus: parser.StatusActive,
+			CurrentStatus:  parser.StatusLikelyAccept,
+			ChangedAt:      time.Date(2026, 1, 21, 12, 0, 0, 0, time.UTC),
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+			RelatedIssues:  []int{22222},
+		},
+		{
+			IssueNumber:    33333,
+			Title:          "proposal: second",
+			PreviousStatus: parser.StatusLikelyAccept,
+			CurrentStatus:  parser.StatusAccepted,
+			ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-2",
+		},
+		{
+			IssueNumber:    44444,
+			Title:          "proposal: third",
+			PreviousStatus: parser.StatusDiscussions,
+			CurrentStatus:  parser.StatusDeclined,
+			ChangedAt:      time.Date(2026, 1, 29, 12, 0, 0, 0, time.UTC),
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-3",
+		},
+	}
+
+	mgr := NewManager(WithBaseDir(t.TempDir()))
+	for _, week := range [][]parser.ProposalChange{original[:1], original[1:]} {
+		if err := mgr.WriteContent(mgr.PrepareContent(week)); err != nil {
+			t.Fatalf("WriteContent() error = %v", err)
+		}
+	}
+
+	got, err := mgr.ReconstructChanges()
+	if err != nil {
+		t.Fatalf("ReconstructChanges() error = %v", err)
+	}
+
+	if len(got) != len(original) {
+		t.Fatalf("ReconstructChanges() returned %d changes, want %d", len(got), len(original))
+	}
+	for i, want := range original {
+		g := got[i]
+		if g.IssueNumber != want.IssueNumber {
+			t.Errorf("changes[%d].IssueNumber = %d, want %d", i, g.IssueNumber, want.IssueNumber)
+		}
+		if g.Title != want.Title {
+			t.Errorf("changes[%d].Title = %q, want %q", i, g.Title, want.Title)
+		}
+		if g.PreviousStatus != want.PreviousStatus || g.CurrentStatus != want.CurrentStatus {
+			t.Errorf("changes[%d] status = %s -> %s, want %s -> %s",
+				i, g.PreviousStatus, g.CurrentStatus, want.PreviousStatus, want.CurrentStatus)
+		}
+		if !g.ChangedAt.Equal(want.ChangedAt) {
+			t.Errorf("changes[%d].ChangedAt = %v, want %v", i, g.ChangedAt, want.ChangedAt)
+		}
+		if g.CommentURL != want.CommentURL {
+			t.Errorf("changes[%d].CommentURL = %q, want %q", i, g.CommentURL, want.CommentURL)
+		}
+		if !slices.Equal(g.RelatedIssues, want.RelatedIssues) {
+			t.Errorf("changes[%d].RelatedIssues = %v, want %v", i, g.RelatedIssues, want.RelatedIssues)
+		}
+	}
+}
+
+func TestManager_ReconstructChanges_Empty(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewManager(WithBaseDir(filepath.Join(t.TempDir(), "missing")))
+	got, err := mgr.ReconstructChanges()
+	if err != nil {
+		t.Fatalf("ReconstructChanges() error = %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ReconstructChanges() = %v, want empty", got)
+	}
+}
+
 func TestManager_ReadExistingContent(t *testing.T) {
 	t.Parallel()
 
@@ -811,6 +1433,64 @@ func TestManager_ReadExistingContent(t *testing.T) {
 	}
 }
 
+func TestManager_SameChangedAtDeterministicOrder(t *testing.T) {
+	t.Parallel()
+
+	sameTime := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	newProposal := func(issue int) ProposalContent {
+		return ProposalContent{
+			IssueNumber:    issue,
+			Title:          fmt.Sprintf("proposal: %d", issue),
+			PreviousStatus: parser.StatusDiscussions,
+			CurrentStatus:  parser.StatusAccepted,
+			ChangedAt:      sameTime,
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-xxx",
+		}
+	}
+	want := []int{11111, 22222, 33333, 44444}
+
+	tmpDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(tmpDir))
+
+	// Repeat to shake out map iteration order
+	for i := range 20 {
+		existing := &WeeklyContent{
+			Year:      2026,
+			Week:      5,
+			Proposals: []ProposalContent{newProposal(33333), newProposal(11111)},
+		}
+		newContent := &WeeklyContent{
+			Year:      2026,
+			Week:      5,
+			Proposals: []ProposalContent{newProposal(44444), newProposal(22222)},
+		}
+
+		merged := mgr.MergeContent(existing, newContent)
+		if got := issueNumbers(merged.Proposals); !slices.Equal(got, want) {
+			t.Fatalf("run %d: MergeContent() order = %v, want %v", i, got, want)
+		}
+
+		if err := mgr.WriteContent(merged); err != nil {
+			t.Fatalf("WriteContent() error = %v", err)
+		}
+		readBack, err := mgr.ReadExistingContent(2026, 5)
+		if err != nil {
+			t.Fatalf("ReadExistingContent() error = %v", err)
+		}
+		if got := issueNumbers(readBack.Proposals); !slices.Equal(got, want) {
+			t.Fatalf("run %d: ReadExistingContent() order = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func issueNumbers(proposals []ProposalContent) []int {
+	numbers := make([]int, len(proposals))
+	for i, p := range proposals {
+		numbers[i] = p.IssueNumber
+	}
+	return numbers
+}
+
 func TestManager_ReadExistingContent_NotExists(t *testing.T) {
 	t.Parallel()
 
@@ -1003,20 +1683,142 @@ func TestManager_IntegrateSummaries(t *testing.T) {
 					}
 				}
 
-				// Check extracted links
-				if wantURLs, ok := tt.wantLinkURLs[p.IssueNumber]; ok {
-					urlSet := make(map[string]bool)
-					for _, link := range p.Links {
-						urlSet[link.URL] = true
-					}
-					for _, url := range wantURLs {
-						if !urlSet[url] {
-							t.Errorf("Proposal[%d] missing expected link URL: %s", p.IssueNumber, url)
-						}
-					}
-				}
-			}
-		})
+				// Check extracted links
+				if wantURLs, ok := tt.wantLinkURLs[p.IssueNumber]; ok {
+					urlSet := make(map[string]bool)
+					for _, link := range p.Links {
+						urlSet[link.URL] = true
+					}
+					for _, url := range wantURLs {
+						if !urlSet[url] {
+							t.Errorf("Proposal[%d] missing expected link URL: %s", p.IssueNumber, url)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestManager_IntegrateSummaries_PostProcessor(t *testing.T) {
+	t.Parallel()
+
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          "proposal: add new feature",
+				PreviousStatus: parser.StatusDiscussions,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	summaries := map[int]string{
+		12345: "このproposalはgolangの新機能です。詳細は[#67890](https://github.com/golang/go/issues/67890)を参照。",
+	}
+
+	var gotIssue int
+	mgr := NewManager(WithSummaryPostProcessor(func(issue int, summary string) string {
+		gotIssue = issue
+		summary = strings.ReplaceAll(summary, "https://github.com/golang/go/issues/67890", "#")
+		return strings.ReplaceAll(summary, "golang", "GOLANG")
+	}))
+
+	if err := mgr.IntegrateSummaries(wc, summaries); err != nil {
+		t.Fatalf("IntegrateSummaries() error = %v", err)
+	}
+
+	if gotIssue != 12345 {
+		t.Errorf("post-processor received issue %d, want 12345", gotIssue)
+	}
+	want := "このproposalはGOLANGの新機能です。詳細は[#67890](#)を参照。"
+	if got := wc.Proposals[0].Summary; got != want {
+		t.Errorf("Summary = %q, want %q", got, want)
+	}
+
+	// Links are extracted from the summary before post-processing
+	if len(wc.Proposals[0].Links) != 1 || wc.Proposals[0].Links[0].URL != "https://github.com/golang/go/issues/67890" {
+		t.Errorf("Links = %+v, want the original summary link", wc.Proposals[0].Links)
+	}
+}
+
+func TestManager_IntegrateSummariesWithReport(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{IssueNumber: 11111, Title: "proposal: short", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 22222, Title: "proposal: long", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 33333, Title: "proposal: fine", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 44444, Title: "proposal: missing", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+		},
+	}
+	summaries := map[int]string{
+		11111: strings.Repeat("短", 100),
+		22222: strings.Repeat("長", 600),
+		33333: strings.Repeat("適", 300),
+	}
+
+	mgr := NewManager()
+	report, err := mgr.IntegrateSummariesWithReport(wc, summaries)
+	if err != nil {
+		t.Fatalf("IntegrateSummariesWithReport() error = %v", err)
+	}
+
+	if len(report) != 2 {
+		t.Errorf("report = %v, want entries for 11111 and 22222 only", report)
+	}
+	if !strings.Contains(report[11111], "too short") {
+		t.Errorf("report[11111] = %q, want a too short reason", report[11111])
+	}
+	if !strings.Contains(report[22222], "too long") {
+		t.Errorf("report[22222] = %q, want a too long reason", report[22222])
+	}
+
+	// Summaries failing validation are still integrated
+	if wc.Proposals[0].Summary != summaries[11111] || wc.Proposals[1].Summary != summaries[22222] {
+		t.Error("summaries failing validation should still be integrated")
+	}
+}
+
+func TestManager_WithLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	mgr := NewManager(WithLogger(logger))
+
+	wc := &WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          "proposal: no summary",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	if _, err := mgr.ApplyFallback(wc); err != nil {
+		t.Fatalf("ApplyFallback() error = %v", err)
+	}
+
+	var found bool
+	for line := range strings.Lines(buf.String()) {
+		if strings.Contains(line, "level=INFO") && strings.Contains(line, `msg="fallback summary applied"`) && strings.Contains(line, "issue=12345") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("logs = %q, want a fallback applied info line for issue 12345", buf.String())
 	}
 }
 
@@ -1030,6 +1832,7 @@ func TestManager_ApplyFallback(t *testing.T) {
 		wantHasFallback     map[int]bool
 		wantContainsStrings map[int][]string
 		name                string
+		wantApplied         []int
 	}{
 		{
 			name: "apply fallback to empty summary with basic info",
@@ -1056,6 +1859,7 @@ func TestManager_ApplyFallback(t *testing.T) {
 			wantContainsStrings: map[int][]string{
 				12345: {"12345", "proposal: add new feature", "discussions", "accepted"},
 			},
+			wantApplied: []int{12345},
 		},
 		{
 			name: "do not apply fallback to existing summary",
@@ -1117,6 +1921,74 @@ func TestManager_ApplyFallback(t *testing.T) {
 			wantContainsStrings: map[int][]string{
 				67890: {"67890", "proposal: feature two", "active", "declined"},
 			},
+			wantApplied: []int{67890},
+		},
+		{
+			name: "mixed - returns only empty-summary issues in ascending order",
+			content: &WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []ProposalContent{
+					{
+						IssueNumber:    300,
+						Title:          "proposal: third",
+						PreviousStatus: parser.StatusActive,
+						CurrentStatus:  parser.StatusLikelyAccept,
+						ChangedAt:      baseTime,
+						Summary:        "",
+					},
+					{
+						IssueNumber:    200,
+						Title:          "proposal: second",
+						PreviousStatus: parser.StatusActive,
+						CurrentStatus:  parser.StatusAccepted,
+						ChangedAt:      baseTime,
+						Summary:        "既存の要約です。",
+					},
+					{
+						IssueNumber:    100,
+						Title:          "proposal: first",
+						PreviousStatus: parser.StatusDiscussions,
+						CurrentStatus:  parser.StatusActive,
+						ChangedAt:      baseTime,
+						Summary:        "",
+					},
+				},
+				CreatedAt: baseTime,
+			},
+			wantHasFallback: map[int]bool{
+				100: true,
+				200: false,
+				300: true,
+			},
+			wantApplied: []int{100, 300},
+		},
+		{
+			name: "fallback replaces a body without summary",
+			content: &WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []ProposalContent{
+					{
+						IssueNumber:    400,
+						Title:          "proposal: stale body",
+						PreviousStatus: parser.StatusActive,
+						CurrentStatus:  parser.StatusHold,
+						ChangedAt:      baseTime,
+						Body:           "## メモ\n\n古い本文です。",
+					},
+				},
+				CreatedAt: baseTime,
+			},
+			wantHasFallback: map[int]bool{
+				400: true,
+			},
+			wantApplied: []int{400},
+		},
+		{
+			name:        "nil content",
+			content:     nil,
+			wantApplied: nil,
 		},
 	}
 
@@ -1125,10 +1997,16 @@ func TestManager_ApplyFallback(t *testing.T) {
 			t.Parallel()
 
 			mgr := NewManager()
-			err := mgr.ApplyFallback(tt.content)
+			applied, err := mgr.ApplyFallback(tt.content)
 			if err != nil {
 				t.Fatalf("ApplyFallback() error = %v", err)
 			}
+			if !slices.Equal(applied, tt.wantApplied) {
+				t.Errorf("ApplyFallback() applied = %v, want %v", applied, tt.wantApplied)
+			}
+			if tt.content == nil {
+				return
+			}
 
 			for _, p := range tt.content.Proposals {
 				wantFallback, ok := tt.wantHasFallback[p.IssueNumber]
@@ -1141,6 +2019,9 @@ func TestManager_ApplyFallback(t *testing.T) {
 					if p.Summary == "" {
 						t.Errorf("Proposal[%d].Summary should have fallback text", p.IssueNumber)
 					}
+					if p.Body != "" {
+						t.Errorf("Proposal[%d].Body = %q, want it cleared by the fallback", p.IssueNumber, p.Body)
+					}
 					// Check for expected strings in fallback
 					if wantStrings, ok := tt.wantContainsStrings[p.IssueNumber]; ok {
 						for _, s := range wantStrings {
@@ -1247,20 +2128,476 @@ func TestManager_ReadSummaries(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			summariesDir := tt.setupDir(t)
-			mgr := NewManager(WithSummariesDir(summariesDir))
+			summariesDir := tt.setupDir(t)
+			mgr := NewManager(WithSummariesDir(summariesDir))
+			summaries, err := mgr.ReadSummaries()
+			if err != nil {
+				t.Fatalf("ReadSummaries() error = %v", err)
+			}
+
+			if len(summaries) != tt.wantLen {
+				t.Errorf("len(summaries) = %d, want %d", len(summaries), tt.wantLen)
+			}
+
+			for issueNum, wantContent := range tt.wantSummaries {
+				if got := summaries[issueNum]; got != wantContent {
+					t.Errorf("summaries[%d] = %q, want %q", issueNum, got, wantContent)
+				}
+			}
+		})
+	}
+}
+
+func TestManager_ReadSummaries_NestedAndExtensions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		files         map[string]string
+		wantSummaries map[int]string
+		name          string
+	}{
+		{
+			name: "per-week subdirectories",
+			files: map[string]string{
+				"2026/W05/12345.md": "W05の要約",
+				"2026/W06/67890.md": "W06の要約",
+				"11111.md":          "トップレベルの要約",
+			},
+			wantSummaries: map[int]string{
+				12345: "W05の要約",
+				67890: "W06の要約",
+				11111: "トップレベルの要約",
+			},
+		},
+		{
+			name: "txt and md extensions",
+			files: map[string]string{
+				"12345.txt":           "テキスト形式の要約",
+				"2026/W05/67890.md":   "Markdown形式の要約",
+				"2026/W05/notes.txt":  "not a summary",
+				"2026/W05/22222.json": "{}",
+			},
+			wantSummaries: map[int]string{
+				12345: "テキスト形式の要約",
+				67890: "Markdown形式の要約",
+			},
+		},
+		{
+			name: "duplicate issue prefers lexicographically latest path",
+			files: map[string]string{
+				"2026/W05/12345.md":  "古い要約",
+				"2026/W06/12345.txt": "新しい要約",
+				"12345.md":           "トップレベルの要約",
+			},
+			wantSummaries: map[int]string{
+				12345: "新しい要約",
+			},
+		},
+		{
+			name: "BOM and CRLF are normalized",
+			files: map[string]string{
+				"12345.md": "\ufeff一行目\r\n\r\n二行目\r\n",
+			},
+			wantSummaries: map[int]string{
+				12345: "一行目\n\n二行目",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			summariesDir := filepath.Join(t.TempDir(), "summaries")
+			for name, body := range tt.files {
+				path := filepath.Join(summariesDir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatalf("Failed to create dir: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+					t.Fatalf("Failed to write summary file: %v", err)
+				}
+			}
+
+			mgr := NewManager(WithSummariesDir(summariesDir))
+			summaries, err := mgr.ReadSummaries()
+			if err != nil {
+				t.Fatalf("ReadSummaries() error = %v", err)
+			}
+			if !maps.Equal(summaries, tt.wantSummaries) {
+				t.Errorf("ReadSummaries() = %v, want %v", summaries, tt.wantSummaries)
+			}
+		})
+	}
+}
+
+func TestManager_ReadSummaries_DuplicateWarning(t *testing.T) {
+	t.Parallel()
+
+	summariesDir := t.TempDir()
+	files := map[string]string{
+		"2026/W05/12345.md": "古い要約",
+		"2026/W06/12345.md": "新しい要約",
+	}
+	for name, body := range files {
+		path := filepath.Join(summariesDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatalf("Failed to write summary file: %v", err)
+		}
+	}
+
+	var warnings strings.Builder
+	mgr := NewManager(
+		WithSummariesDir(summariesDir),
+		WithLogger(slog.New(slog.NewTextHandler(&warnings, nil))),
+	)
+	if _, err := mgr.ReadSummaries(); err != nil {
+		t.Fatalf("ReadSummaries() error = %v", err)
+	}
+
+	out := warnings.String()
+	if !strings.Contains(out, "duplicate summary files") || !strings.Contains(out, "issue=12345") {
+		t.Errorf("logger should warn about duplicate summaries for #12345, got: %q", out)
+	}
+}
+
+func TestManager_ReadSummariesWithMeta(t *testing.T) {
+	t.Parallel()
+
+	summariesDir := filepath.Join(t.TempDir(), "summaries")
+	files := map[string]string{
+		"2026/W05/12345.md": "  W05の要約\n\n",
+		"67890.txt":         "テキストの要約",
+		"67890.en.md":       "English summary",
+	}
+	modTime := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	for name, body := range files {
+		path := filepath.Join(summariesDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("Failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+			t.Fatalf("Failed to write summary file: %v", err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatalf("Failed to set mtime: %v", err)
+		}
+	}
+
+	mgr := NewManager(WithSummariesDir(summariesDir))
+	metas, err := mgr.ReadSummariesWithMeta()
+	if err != nil {
+		t.Fatalf("ReadSummariesWithMeta() error = %v", err)
+	}
+
+	want := map[int]SummaryMeta{
+		12345: {
+			Content:  "W05の要約",
+			Language: DefaultSummaryLanguage,
+			Path:     filepath.Join(summariesDir, "2026", "W05", "12345.md"),
+			Size:     int64(len(files["2026/W05/12345.md"])),
+			ModTime:  modTime,
+		},
+		67890: {
+			Content:  "テキストの要約",
+			Language: DefaultSummaryLanguage,
+			Path:     filepath.Join(summariesDir, "67890.txt"),
+			Size:     int64(len(files["67890.txt"])),
+			ModTime:  modTime,
+		},
+	}
+	if len(metas) != len(want) {
+		t.Fatalf("ReadSummariesWithMeta() returned %d entries, want %d: %+v", len(metas), len(want), metas)
+	}
+	for issue, w := range want {
+		got, ok := metas[issue]
+		if !ok {
+			t.Errorf("missing meta for #%d", issue)
+			continue
+		}
+		if got.Content != w.Content || got.Language != w.Language || got.Path != w.Path || got.Size != w.Size || !got.ModTime.Equal(w.ModTime) {
+			t.Errorf("meta for #%d = %+v, want %+v", issue, got, w)
+		}
+	}
+
+	// Content matches ReadSummaries
+	summaries, err := mgr.ReadSummaries()
+	if err != nil {
+		t.Fatalf("ReadSummaries() error = %v", err)
+	}
+	for issue, meta := range metas {
+		if summaries[issue] != meta.Content {
+			t.Errorf("ReadSummaries()[%d] = %q, want %q", issue, summaries[issue], meta.Content)
+		}
+	}
+}
+
+func TestManager_ReadSummaries_Languages(t *testing.T) {
+	t.Parallel()
+
+	summariesDir := t.TempDir()
+	files := map[string]string{
+		"11111.md":    "日本語の要約（サフィックスなし）",
+		"11111.en.md": "English summary",
+		"22222.md":    "日本語のみ",
+		"33333.en.md": "English only",
+		"44444.md":    "サフィックスなし",
+		"44444.ja.md": "サフィックスあり",
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(summariesDir, name), []byte(body), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+
+	tests := []struct {
+		want map[int]string
+		name string
+		opts []Option
+	}{
+		{
+			name: "defaults to Japanese",
+			want: map[int]string{
+				11111: "日本語の要約（サフィックスなし）",
+				22222: "日本語のみ",
+				33333: "English only",
+				44444: "サフィックスあり",
+			},
+		},
+		{
+			name: "prefers configured language with fallback to default",
+			opts: []Option{WithSummaryLanguage("en")},
+			want: map[int]string{
+				11111: "English summary",
+				22222: "日本語のみ",
+				33333: "English only",
+				44444: "サフィックスあり",
+			},
+		},
+		{
+			name: "unsuffixed files use the configured default language",
+			opts: []Option{WithSummaryLanguage("en"), WithDefaultSummaryLanguage("en")},
+			want: map[int]string{
+				11111: "English summary",
+				22222: "日本語のみ",
+				33333: "English only",
+				44444: "サフィックスなし",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := NewManager(append([]Option{WithSummariesDir(summariesDir)}, tt.opts...)...)
+			got, err := mgr.ReadSummaries()
+			if err != nil {
+				t.Fatalf("ReadSummaries() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("len(summaries) = %d, want %d", len(got), len(tt.want))
+			}
+			for issue, want := range tt.want {
+				if got[issue] != want {
+					t.Errorf("summaries[%d] = %q, want %q", issue, got[issue], want)
+				}
+			}
+		})
+	}
+}
+
+func TestManager_IntegrateLocalizedSummaries(t *testing.T) {
+	t.Parallel()
+
+	summaries := map[int]LocalizedSummary{
+		11111: {"ja": "日本語の要約", "en": "English summary"},
+		22222: {"ja": "日本語のみ"},
+	}
+
+	tests := []struct {
+		wantSummary map[int]string
+		wantLang    map[int]string
+		name        string
+		lang        string
+	}{
+		{
+			name:        "selects English",
+			lang:        "en",
+			wantSummary: map[int]string{11111: "English summary", 22222: "日本語のみ"},
+			wantLang:    map[int]string{11111: "en", 22222: "ja"},
+		},
+		{
+			name:        "falls back to default for unknown language",
+			lang:        "fr",
+			wantSummary: map[int]string{11111: "日本語の要約", 22222: "日本語のみ"},
+			wantLang:    map[int]string{11111: "ja", 22222: "ja"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wc := &WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []ProposalContent{
+					{IssueNumber: 11111, Title: "proposal: one"},
+					{IssueNumber: 22222, Title: "proposal: two"},
+				},
+			}
+			mgr := NewManager(WithSummaryLanguage(tt.lang))
+			if err := mgr.IntegrateLocalizedSummaries(wc, summaries); err != nil {
+				t.Fatalf("IntegrateLocalizedSummaries() error = %v", err)
+			}
+			for _, p := range wc.Proposals {
+				if p.Summary != tt.wantSummary[p.IssueNumber] {
+					t.Errorf("#%d Summary = %q, want %q", p.IssueNumber, p.Summary, tt.wantSummary[p.IssueNumber])
+				}
+				if p.SummaryLanguage != tt.wantLang[p.IssueNumber] {
+					t.Errorf("#%d SummaryLanguage = %q, want %q", p.IssueNumber, p.SummaryLanguage, tt.wantLang[p.IssueNumber])
+				}
+			}
+
+			// The selected language survives a write/read round trip.
+			mgr = NewManager(WithBaseDir(t.TempDir()))
+			for i := range wc.Proposals {
+				wc.Proposals[i].CurrentStatus = parser.StatusActive
+				wc.Proposals[i].ChangedAt = time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+				wc.Proposals[i].CommentURL = "https://example.com"
+			}
+			if err := mgr.WriteContent(wc); err != nil {
+				t.Fatalf("WriteContent() error = %v", err)
+			}
+			read, err := mgr.ReadExistingContent(2026, 5)
+			if err != nil {
+				t.Fatalf("ReadExistingContent() error = %v", err)
+			}
+			for _, p := range read.Proposals {
+				if p.SummaryLanguage != tt.wantLang[p.IssueNumber] {
+					t.Errorf("#%d SummaryLanguage after round trip = %q, want %q", p.IssueNumber, p.SummaryLanguage, tt.wantLang[p.IssueNumber])
+				}
+			}
+		})
+	}
+}
+
+func TestManager_ReadSummaries_IssueMismatchCheck(t *testing.T) {
+	t.Parallel()
+
+	summariesDir := t.TempDir()
+	files := map[string]string{
+		// Copy-paste mistake: describes #67890
+		"12345.md": "[#67890](https://github.com/golang/go/issues/67890)は新しいAPIを追加します。#67890の議論では…",
+		// Consistent: mostly references itself
+		"22222.md": "#22222は#33333に関連します。#22222の詳細は…",
+		// No references at all
+		"44444.md": "参照のない要約です。",
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(summariesDir, name), []byte(body), 0o644); err != nil {
+			t.Fatalf("failed to write summary: %v", err)
+		}
+	}
+
+	var warnings strings.Builder
+	mgr := NewManager(
+		WithSummariesDir(summariesDir),
+		WithIssueMismatchCheck(true),
+		WithLogger(slog.New(slog.NewTextHandler(&warnings, nil))),
+	)
+
+	summaries, err := mgr.ReadSummaries()
+	if err != nil {
+		t.Fatalf("ReadSummaries() error = %v", err)
+	}
+	if len(summaries) != len(files) {
+		t.Errorf("len(summaries) = %d, want %d", len(summaries), len(files))
+	}
+
+	out := warnings.String()
+	if !strings.Contains(out, "12345.md") || !strings.Contains(out, "referenced=67890") {
+		t.Errorf("warnings should flag 12345.md referencing #67890, got: %q", out)
+	}
+	if strings.Count(out, "level=WARN") != 1 {
+		t.Errorf("expected exactly one warning, got: %q", out)
+	}
+}
+
+func TestMismatchedIssue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		summary string
+		issue   int
+		want    int
+		wantOK  bool
+	}{
+		{name: "no references", issue: 1, summary: "text"},
+		{name: "references itself", issue: 1, summary: "#1 and #2 and #1"},
+		{name: "tie including itself", issue: 1, summary: "#1 and #2"},
+		{name: "other issue dominates", issue: 1, summary: "#2 #2 #1", want: 2, wantOK: true},
+		{name: "issue URLs count", issue: 1, summary: "https://github.com/golang/go/issues/3", want: 3, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := mismatchedIssue(tt.issue, tt.summary)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("mismatchedIssue() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestManager_ReadSummaries_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	const numFiles = 500
+
+	summariesDir := filepath.Join(t.TempDir(), "summaries")
+	if err := os.MkdirAll(summariesDir, 0o755); err != nil {
+		t.Fatalf("Failed to create summaries dir: %v", err)
+	}
+
+	want := make(map[int]string, numFiles)
+	for i := range numFiles {
+		issueNumber := 10000 + i
+		summary := fmt.Sprintf("proposal #%d の要約です。", issueNumber)
+		want[issueNumber] = summary
+		path := filepath.Join(summariesDir, fmt.Sprintf("%d.md", issueNumber))
+		if err := os.WriteFile(path, []byte(summary+"\n"), 0o644); err != nil {
+			t.Fatalf("Failed to write summary file: %v", err)
+		}
+	}
+
+	for _, concurrency := range []int{0, 1, 8, 64} {
+		t.Run(fmt.Sprintf("concurrency=%d", concurrency), func(t *testing.T) {
+			t.Parallel()
+
+			mgr := NewManager(
+				WithSummariesDir(summariesDir),
+				WithReadConcurrency(concurrency),
+			)
+
 			summaries, err := mgr.ReadSummaries()
 			if err != nil {
 				t.Fatalf("ReadSummaries() error = %v", err)
 			}
 
-			if len(summaries) != tt.wantLen {
-				t.Errorf("len(summaries) = %d, want %d", len(summaries), tt.wantLen)
+			if len(summaries) != len(want) {
+				t.Fatalf("len(summaries) = %d, want %d", len(summaries), len(want))
 			}
-
-			for issueNum, wantContent := range tt.wantSummaries {
-				if got := summaries[issueNum]; got != wantContent {
-					t.Errorf("summaries[%d] = %q, want %q", issueNum, got, wantContent)
+			for issueNumber, wantSummary := range want {
+				if got := summaries[issueNumber]; got != wantSummary {
+					t.Errorf("summaries[%d] = %q, want %q", issueNumber, got, wantSummary)
 				}
 			}
 		})
@@ -1473,6 +2810,135 @@ func TestIntegrateSummaries_WithReasonBackgroundLinks(t *testing.T) {
 
 // TestValidateSummaryLength tests the validation of summary character count.
 // Summaries should ideally be 200-500 characters as per requirements.
+func TestTruncateSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		summary  string
+		want     string
+		maxRunes int
+	}{
+		{
+			name:     "shorter than limit is unchanged",
+			summary:  "短い要約",
+			maxRunes: 10,
+			want:     "短い要約",
+		},
+		{
+			name:     "exactly at limit is unchanged",
+			summary:  strings.Repeat("あ", 10),
+			maxRunes: 10,
+			want:     strings.Repeat("あ", 10),
+		},
+		{
+			name:     "one rune over limit is truncated",
+			summary:  strings.Repeat("あ", 11),
+			maxRunes: 10,
+			want:     strings.Repeat("あ", 9) + "…",
+		},
+		{
+			name:     "mixed ASCII and Japanese cut at rune boundary",
+			summary:  "Go言語のジェネリクスに関する提案です",
+			maxRunes: 8,
+			want:     "Go言語のジェ…",
+		},
+		{
+			name:     "trailing whitespace before ellipsis is trimmed",
+			summary:  "提案 の 要約 です",
+			maxRunes: 4,
+			want:     "提案…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := truncateSummary(tt.summary, tt.maxRunes)
+			if got != tt.want {
+				t.Errorf("truncateSummary() = %q, want %q", got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateSummary() returned invalid UTF-8: %q", got)
+			}
+			if n := utf8.RuneCountInString(got); n > tt.maxRunes {
+				t.Errorf("truncateSummary() returned %d runes, want at most %d", n, tt.maxRunes)
+			}
+		})
+	}
+}
+
+func TestManager_WriteContent_MaxSummaryLength(t *testing.T) {
+	t.Parallel()
+
+	longSummary := strings.Repeat("日本語", 200) // 600 runes, 1800 bytes
+
+	tests := []struct {
+		name        string
+		body        string
+		opts        []Option
+		wantSummary string
+	}{
+		{
+			name:        "unset leaves summary unchanged",
+			wantSummary: longSummary,
+		},
+		{
+			name:        "over-length summary is truncated with ellipsis",
+			opts:        []Option{WithMaxSummaryLength(SummaryMaxLength)},
+			wantSummary: strings.Repeat("日本語", 166) + "日" + "…",
+		},
+		{
+			name:        "truncation replaces a body read from an existing file",
+			body:        "## 概要\n\n" + longSummary + "\n\n## メモ\n\n追記",
+			opts:        []Option{WithMaxSummaryLength(SummaryMaxLength)},
+			wantSummary: strings.Repeat("日本語", 166) + "日" + "…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseDir := t.TempDir()
+			mgr := NewManager(append([]Option{WithBaseDir(baseDir)}, tt.opts...)...)
+			weekly := &WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []ProposalContent{{
+					IssueNumber:   12345,
+					Title:         "proposal: long summary",
+					CurrentStatus: parser.StatusActive,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+					CommentURL:    "https://example.com",
+					Summary:       longSummary,
+					Body:          tt.body,
+				}},
+			}
+			if err := mgr.WriteContent(weekly); err != nil {
+				t.Fatalf("WriteContent() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(baseDir, "2026", "W05", "proposal-12345.md"))
+			if err != nil {
+				t.Fatalf("failed to read written file: %v", err)
+			}
+			if !utf8.Valid(data) {
+				t.Fatal("written file is not valid UTF-8")
+			}
+			if !strings.Contains(string(data), "---\n"+tt.wantSummary+"\n\n## 関連リンク") {
+				t.Errorf("written file should contain summary %q, got:\n%s", tt.wantSummary, data)
+			}
+
+			// The caller's content must not be modified
+			if weekly.Proposals[0].Summary != longSummary {
+				t.Error("WriteContent() modified the caller's summary")
+			}
+		})
+	}
+}
+
 func TestValidateSummaryLength(t *testing.T) {
 	t.Parallel()
 
@@ -1569,6 +3035,21 @@ func TestExtractLinksFromMarkdown(t *testing.T) {
 			text:     "[review comment](https://github.com/golang/go/issues/33502#issuecomment-1234567890)",
 			wantURLs: []string{"https://github.com/golang/go/issues/33502#issuecomment-1234567890"},
 		},
+		{
+			name:     "design doc link captured",
+			text:     "[設計ドキュメント](https://go.dev/design/12345-feature) を参照",
+			wantURLs: []string{"https://go.dev/design/12345-feature"},
+		},
+		{
+			name:     "golang.org/x repository link captured",
+			text:     "[x/tools](https://go.googlesource.com/tools/+/refs/heads/master/go/ast/inspector)",
+			wantURLs: []string{"https://go.googlesource.com/tools/+/refs/heads/master/go/ast/inspector"},
+		},
+		{
+			name:     "blog link ignored",
+			text:     "[blog](https://someone.example.net/posts/go-iterators) [design](https://go.dev/design/1-x)",
+			wantURLs: []string{"https://go.dev/design/1-x"},
+		},
 		{
 			name:     "mixed links with and without anchors",
 			text:     "[issue](https://github.com/golang/go/issues/12345) [comment](https://github.com/golang/go/issues/67890#issuecomment-999)",
@@ -1580,7 +3061,7 @@ func TestExtractLinksFromMarkdown(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			links := extractLinksFromMarkdown(tt.text)
+			links := extractLinksFromMarkdown(tt.text, DefaultAllowedLinkHosts)
 
 			if len(tt.wantURLs) == 0 && len(links) == 0 {
 				return // Both empty, pass
@@ -1605,6 +3086,40 @@ func TestExtractLinksFromMarkdown(t *testing.T) {
 	}
 }
 
+func TestManager_IntegrateSummaries_AllowedLinkHosts(t *testing.T) {
+	t.Parallel()
+
+	summary := "## 概要\n\n[design](https://go.dev/design/1-x) [blog](https://blog.example.com/go) [pkg](https://pkg.go.dev/iter)"
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		wantURLs []string
+	}{
+		{name: "default allowlist", wantURLs: []string{"https://go.dev/design/1-x"}},
+		{name: "custom patterns", opts: []Option{WithAllowedLinkHosts("*.go.dev", "blog.example.com")}, wantURLs: []string{"https://blog.example.com/go", "https://pkg.go.dev/iter"}},
+		{name: "no extra hosts", opts: []Option{WithAllowedLinkHosts()}, wantURLs: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			wc := &WeeklyContent{Proposals: []ProposalContent{{IssueNumber: 1}}}
+			if err := NewManager(tt.opts...).IntegrateSummaries(wc, map[int]string{1: summary}); err != nil {
+				t.Fatalf("IntegrateSummaries() error = %v", err)
+			}
+			var gotURLs []string
+			for _, link := range wc.Proposals[0].Links {
+				gotURLs = append(gotURLs, link.URL)
+			}
+			if !slices.Equal(gotURLs, tt.wantURLs) {
+				t.Errorf("Links URLs = %v, want %v", gotURLs, tt.wantURLs)
+			}
+		})
+	}
+}
+
 // TestManager_ListAllWeeks tests listing all weekly contents from the content directory.
 func TestManager_ListAllWeeks(t *testing.T) {
 	t.Parallel()
@@ -1818,7 +3333,7 @@ Test summary
 		t.Fatalf("failed to write test file: %v", err)
 	}
 
-	_, err := parseProposalFile(filePath)
+	_, err := parseProposalFile(osFS{}, filePath, defaultHeadings)
 	if err == nil {
 		t.Error("parseProposalFile() should return error for invalid issue_number (overflow)")
 	}
@@ -1910,7 +3425,7 @@ changed_at: 2026-01-30T12:00:00Z
 				t.Fatalf("failed to write test file: %v", err)
 			}
 
-			_, err := parseProposalFile(filePath)
+			_, err := parseProposalFile(osFS{}, filePath, defaultHeadings)
 			if err == nil {
 				t.Errorf("parseProposalFile() should return error for %s", tt.name)
 			}
@@ -1946,13 +3461,209 @@ Test summary
 		t.Fatalf("failed to write test file: %v", err)
 	}
 
-	_, err := parseProposalFile(filePath)
+	_, err := parseProposalFile(osFS{}, filePath, defaultHeadings)
 	if err == nil {
-		t.Error("parseProposalFile() should return error for invalid changed_at")
+		t.Fatal("parseProposalFile() should return error for invalid changed_at")
 	}
 	if !strings.Contains(err.Error(), "changed_at") {
 		t.Errorf("error should mention changed_at, got: %v", err)
 	}
+	if want := filePath + ":6:"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error should contain %q, got: %v", want, err)
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("error should be a *ParseError, got %T", err)
+	}
+	if pe.Line != 6 || pe.Field != "changed_at" || pe.Path != filePath {
+		t.Errorf("ParseError = {Path: %q, Line: %d, Field: %q}, want {%q, 6, changed_at}", pe.Path, pe.Line, pe.Field, filePath)
+	}
+}
+
+// TestParseProposalFile_YAMLFrontmatter tests that the frontmatter is parsed as YAML
+// rather than matched line by line.
+func TestParseProposalFile_YAMLFrontmatter(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		want    ProposalContent
+		name    string
+		content string
+	}{
+		{
+			name: "title with escaped quote",
+			content: `---
+issue_number: 12345
+title: "proposal: add \"quoted\" \\ names"
+previous_status: active
+current_status: accepted
+changed_at: 2026-01-28T12:00:00Z
+comment_url: https://github.com/golang/go/issues/33502#issuecomment-1
+related_issues:
+  - title: "say \"hi\""
+    url: https://github.com/golang/go/issues/1
+---
+
+## 概要
+
+Summary
+`,
+			want: ProposalContent{
+				IssueNumber:    12345,
+				Title:          `proposal: add "quoted" \ names`,
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      changedAt,
+				CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+				Links:          []Link{{Title: `say "hi"`, URL: "https://github.com/golang/go/issues/1"}},
+			},
+		},
+		{
+			name: "reordered fields",
+			content: `---
+related_issues:
+  - url: https://github.com/golang/go/issues/2
+    title: "linked"
+comment_url: https://github.com/golang/go/issues/33502#issuecomment-2
+changed_at: 2026-01-28T12:00:00Z
+current_status: declined
+title: 'proposal: single-quoted title'
+summary_language: en
+issue_number: 67890
+---
+
+## 概要
+
+Summary
+`,
+			want: ProposalContent{
+				IssueNumber:     67890,
+				Title:           "proposal: single-quoted title",
+				CurrentStatus:   parser.StatusDeclined,
+				ChangedAt:       changedAt,
+				CommentURL:      "https://github.com/golang/go/issues/33502#issuecomment-2",
+				SummaryLanguage: "en",
+				Links:           []Link{{Title: "linked", URL: "https://github.com/golang/go/issues/2"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filePath := filepath.Join(t.TempDir(), "proposal.md")
+			if err := os.WriteFile(filePath, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			got, err := parseProposalFile(osFS{}, filePath, defaultHeadings)
+			if err != nil {
+				t.Fatalf("parseProposalFile() error = %v", err)
+			}
+			if want := "Summary"; got.Summary != want {
+				t.Errorf("Summary = %q, want %q", got.Summary, want)
+			}
+			got.Summary, got.FullContent, got.Body = "", "", ""
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("parseProposalFile() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManager_ValidateAll(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+	if err := mgr.WriteContent(&WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{IssueNumber: 1, Title: "proposal: valid", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com"},
+		},
+	}); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	if err := mgr.ValidateAll(); err != nil {
+		t.Fatalf("ValidateAll() on valid content error = %v", err)
+	}
+
+	badDate := filepath.Join(baseDir, "2026", "W05", "proposal-2.md")
+	if err := os.WriteFile(badDate, []byte("---\nissue_number: 2\ntitle: \"x\"\ncurrent_status: active\nchanged_at: yesterday\ncomment_url: https://example.com\n---\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	missingTitle := filepath.Join(baseDir, "2026", "W05", "proposal-3.md")
+	if err := os.WriteFile(missingTitle, []byte("---\nissue_number: 3\ncurrent_status: active\nchanged_at: 2026-01-28T12:00:00Z\ncomment_url: https://example.com\n---\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := mgr.ValidateAll()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("ValidateAll() error = %v, want *ValidationError", err)
+	}
+	if len(verr.Errors) != 2 {
+		t.Fatalf("ValidateAll() reported %d errors, want 2: %v", len(verr.Errors), err)
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		badDate + ":\n  line 5: changed_at:",
+		missingTitle + ":\n  title: missing required field",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("ValidateAll() error should contain %q, got:\n%s", want, msg)
+		}
+	}
+}
+
+func TestManager_ValidateAll_Checks(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	if err := mgr.WriteContent(&WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []ProposalContent{
+			{IssueNumber: 1, Title: "proposal: valid", CurrentStatus: parser.StatusActive, ChangedAt: changedAt, CommentURL: "https://example.com", Summary: "## 概要\n\n" + strings.Repeat("あ", SummaryMinLength)},
+			{IssueNumber: 2, Title: "proposal: short", CurrentStatus: parser.StatusActive, ChangedAt: changedAt, CommentURL: "https://example.com", Summary: "## 概要\n\n短い"},
+			{IssueNumber: 3, Title: "proposal: none", CurrentStatus: parser.StatusActive, ChangedAt: changedAt, CommentURL: "https://example.com"},
+		},
+	}); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	// Checks are opt-in
+	if err := mgr.ValidateAll(); err != nil {
+		t.Fatalf("ValidateAll() without checks error = %v", err)
+	}
+
+	err := mgr.ValidateAll(CheckSummaryLength)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("ValidateAll() error = %v, want *ValidationError", err)
+	}
+	if len(verr.Errors) != 2 {
+		t.Fatalf("ValidateAll() reported %d errors, want 2: %v", len(verr.Errors), err)
+	}
+
+	msg := err.Error()
+	for _, want := range []string{
+		filepath.Join(baseDir, "2026", "W05", "proposal-2.md") + ":\n  summary too short",
+		filepath.Join(baseDir, "2026", "W05", "proposal-3.md") + ":\n  summary is missing",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("ValidateAll() error should contain %q, got:\n%s", want, msg)
+		}
+	}
 }
 
 // TestManager_ListAllWeeks_ErrorOnCorruptedFile tests that ListAllWeeks returns error when file is corrupted.
@@ -1981,11 +3692,115 @@ comment_url: https://example.com
 		t.Fatalf("failed to write corrupted file: %v", err)
 	}
 
-	mgr := NewManager(WithBaseDir(tmpDir))
-	_, err := mgr.ListAllWeeks()
-	if err == nil {
-		t.Error("ListAllWeeks() should return error when file is corrupted")
-	}
+	mgr := NewManager(WithBaseDir(tmpDir))
+	_, err := mgr.ListAllWeeks()
+	if err == nil {
+		t.Error("ListAllWeeks() should return error when file is corrupted")
+	}
+}
+
+// TestManager_WithFS tests that all read operations use the injected file system.
+func TestManager_WithFS(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	proposal := func(issue int, at time.Time) *fstest.MapFile {
+		return &fstest.MapFile{Data: []byte(generateMarkdown(ProposalContent{
+			IssueNumber:    issue,
+			Title:          fmt.Sprintf("proposal: test %d", issue),
+			PreviousStatus: parser.StatusActive,
+			CurrentStatus:  parser.StatusLikelyAccept,
+			ChangedAt:      at,
+			CommentURL:     "https://github.com/golang/go/issues/33502#issuecomment-1",
+		}, LineEndingLF, defaultHeadings))}
+	}
+
+	fsys := fstest.MapFS{
+		"content/2026/W01/proposal-100.md": proposal(100, changedAt),
+		"content/2026/W02/proposal-200.md": proposal(200, changedAt.AddDate(0, 0, 7)),
+		"content/2026/W02/proposal-201.md": proposal(201, changedAt.AddDate(0, 0, 7)),
+		"content/2026/W02/notes.txt":       {Data: []byte("ignored")},
+		"summaries/100.md":                 {Data: []byte("  summary for 100\n")},
+		"summaries/200.md":                 {Data: []byte("summary for 200")},
+	}
+
+	mgr := NewManager(
+		WithFS(fsys),
+		WithBaseDir("content"),
+		WithSummariesDir("summaries"),
+	)
+
+	t.Run("ListAllWeeks", func(t *testing.T) {
+		t.Parallel()
+
+		weeks, err := mgr.ListAllWeeks()
+		if err != nil {
+			t.Fatalf("ListAllWeeks() error = %v", err)
+		}
+		if len(weeks) != 2 {
+			t.Fatalf("ListAllWeeks() returned %d weeks, want 2", len(weeks))
+		}
+		if weeks[0].Week != 2 || weeks[1].Week != 1 {
+			t.Errorf("weeks = W%02d, W%02d, want W02, W01", weeks[0].Week, weeks[1].Week)
+		}
+		if got := issueNumbers(weeks[0].Proposals); !slices.Equal(got, []int{200, 201}) {
+			t.Errorf("W02 proposals = %v, want [200 201]", got)
+		}
+	})
+
+	t.Run("ReadExistingContent", func(t *testing.T) {
+		t.Parallel()
+
+		wc, err := mgr.ReadExistingContent(2026, 1)
+		if err != nil {
+			t.Fatalf("ReadExistingContent() error = %v", err)
+		}
+		if wc == nil || len(wc.Proposals) != 1 || wc.Proposals[0].IssueNumber != 100 {
+			t.Fatalf("ReadExistingContent() = %+v, want proposal #100", wc)
+		}
+		if !wc.Proposals[0].ChangedAt.Equal(changedAt) {
+			t.Errorf("ChangedAt = %v, want %v", wc.Proposals[0].ChangedAt, changedAt)
+		}
+
+		missing, err := mgr.ReadExistingContent(2026, 3)
+		if err != nil || missing != nil {
+			t.Errorf("ReadExistingContent() for missing week = %v, %v, want nil, nil", missing, err)
+		}
+	})
+
+	t.Run("ReadSummaries", func(t *testing.T) {
+		t.Parallel()
+
+		summaries, err := mgr.ReadSummaries()
+		if err != nil {
+			t.Fatalf("ReadSummaries() error = %v", err)
+		}
+		want := map[int]string{100: "summary for 100", 200: "summary for 200"}
+		if len(summaries) != len(want) {
+			t.Fatalf("ReadSummaries() = %v, want %v", summaries, want)
+		}
+		for issue, summary := range want {
+			if summaries[issue] != summary {
+				t.Errorf("summaries[%d] = %q, want %q", issue, summaries[issue], summary)
+			}
+		}
+	})
+
+	t.Run("root of file system", func(t *testing.T) {
+		t.Parallel()
+
+		sub, err := fs.Sub(fsys, "content")
+		if err != nil {
+			t.Fatalf("fs.Sub() error = %v", err)
+		}
+		weeks, err := NewManager(WithFS(sub), WithBaseDir(".")).ListAllWeeks()
+		if err != nil {
+			t.Fatalf("ListAllWeeks() error = %v", err)
+		}
+		if len(weeks) != 2 {
+			t.Errorf("ListAllWeeks() returned %d weeks, want 2", len(weeks))
+		}
+	})
 }
 
 // TestGenerateFallbackSummary tests the fallback summary generation.
@@ -2084,3 +3899,575 @@ func TestGenerateFallbackSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_PruneOlderThan(t *testing.T) {
+	t.Parallel()
+
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	allWeeks := []string{"2025/W01", "2025/W10", "2025/W50", "2026/W01", "2026/W02"}
+
+	tests := []struct {
+		name         string
+		cutoff       time.Time
+		wantPruned   []string
+		keep         int
+		deleteWeeks  bool
+		wantArchived bool
+	}{
+		{
+			name:         "archives weeks ended before cutoff",
+			cutoff:       cutoff,
+			keep:         2,
+			wantPruned:   []string{"2025/W01", "2025/W10", "2025/W50"},
+			wantArchived: true,
+		},
+		{
+			name:         "never touches the most recent weeks",
+			cutoff:       cutoff,
+			keep:         4,
+			wantPruned:   []string{"2025/W01"},
+			wantArchived: true,
+		},
+		{
+			name:        "deletes instead of archiving",
+			cutoff:      cutoff,
+			keep:        2,
+			deleteWeeks: true,
+			wantPruned:  []string{"2025/W01", "2025/W10", "2025/W50"},
+		},
+		{
+			name:   "no-op when nothing qualifies",
+			cutoff: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			keep:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseDir := t.TempDir()
+			for i, week := range allWeeks {
+				dir := filepath.Join(baseDir, filepath.FromSlash(week))
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatalf("failed to create %s: %v", dir, err)
+				}
+				data := generateMarkdown(ProposalContent{
+					IssueNumber:   i + 1,
+					Title:         "proposal: " + week,
+					CurrentStatus: parser.StatusActive,
+					ChangedAt:     cutoff,
+					CommentURL:    "https://github.com/golang/go/issues/33502#issuecomment-1",
+				}, LineEndingLF, defaultHeadings)
+				if err := os.WriteFile(filepath.Join(dir, proposalFilename(i+1)), []byte(data), 0o644); err != nil {
+					t.Fatalf("failed to write proposal: %v", err)
+				}
+			}
+
+			mgr := NewManager(WithBaseDir(baseDir), WithPruneDelete(tt.deleteWeeks))
+			pruned, err := mgr.PruneOlderThan(tt.cutoff, tt.keep)
+			if err != nil {
+				t.Fatalf("PruneOlderThan() error = %v", err)
+			}
+			if !slices.Equal(pruned, tt.wantPruned) {
+				t.Errorf("pruned = %v, want %v", pruned, tt.wantPruned)
+			}
+
+			for _, week := range allWeeks {
+				_, err := os.Stat(filepath.Join(baseDir, filepath.FromSlash(week)))
+				gone, wantGone := errors.Is(err, fs.ErrNotExist), slices.Contains(tt.wantPruned, week)
+				if gone != wantGone {
+					t.Errorf("%s: removed = %v, want %v", week, gone, wantGone)
+				}
+			}
+
+			archiveDir := filepath.Join(baseDir, DefaultArchiveDir)
+			if !tt.wantArchived {
+				if _, err := os.Stat(archiveDir); !errors.Is(err, fs.ErrNotExist) {
+					t.Errorf("archive directory should not exist, stat err = %v", err)
+				}
+				return
+			}
+
+			// Remaining weeks are still listed and archived weeks are readable from the archive
+			remaining, err := mgr.ListAllWeeks()
+			if err != nil {
+				t.Fatalf("ListAllWeeks() error = %v", err)
+			}
+			if want := len(allWeeks) - len(tt.wantPruned); len(remaining) != want {
+				t.Errorf("ListAllWeeks() returned %d weeks, want %d", len(remaining), want)
+			}
+			archived, err := NewManager(WithBaseDir(archiveDir)).ListAllWeeks()
+			if err != nil {
+				t.Fatalf("ListAllWeeks() of archive error = %v", err)
+			}
+			if len(archived) != len(tt.wantPruned) {
+				t.Errorf("archive contains %d weeks, want %d", len(archived), len(tt.wantPruned))
+			}
+		})
+	}
+}
+
+func TestMergeLinks_DeterministicOrder(t *testing.T) {
+	t.Parallel()
+
+	existing := []Link{
+		{Title: "proposal issue", URL: "https://github.com/golang/go/issues/100"},
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/300"},
+		{Title: "Design doc", URL: "https://go.dev/design/100"},
+	}
+	newLinks := []Link{
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/200"},
+		{Title: "CL", URL: "https://go-review.googlesource.com/c/go/+/1"},
+		{Title: "Design document", URL: "https://go.dev/design/100"},
+		{Title: "Review Minutes", URL: "https://github.com/golang/go/issues/33502#issuecomment-1"},
+	}
+
+	got := mergeLinks(100, existing, newLinks)
+	want := []Link{
+		{Title: "proposal issue", URL: "https://github.com/golang/go/issues/100"},
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/200"},
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/300"},
+		{Title: "Design document", URL: "https://go.dev/design/100"},
+		{Title: "CL", URL: "https://go-review.googlesource.com/c/go/+/1"},
+		{Title: "Review Minutes", URL: "https://github.com/golang/go/issues/33502#issuecomment-1"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("mergeLinks() =\n%v\nwant\n%v", got, want)
+	}
+
+	// Merging the same inputs repeatedly yields byte-identical markdown
+	p := ProposalContent{
+		IssueNumber:   100,
+		Title:         "proposal: stable links",
+		CurrentStatus: parser.StatusActive,
+		ChangedAt:     time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC),
+		CommentURL:    "https://github.com/golang/go/issues/33502#issuecomment-1",
+	}
+	first := ""
+	for i := range 20 {
+		p.Links = newLinks
+		md := generateMarkdown(mergeProposal(ProposalContent{Links: existing}, p), LineEndingLF, defaultHeadings)
+		if i == 0 {
+			first = md
+			continue
+		}
+		if md != first {
+			t.Fatalf("merge %d produced different markdown:\n%s\nwant:\n%s", i, md, first)
+		}
+	}
+}
+
+func TestManager_ExportJSON(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewManager(WithBaseDir(t.TempDir()))
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	input := []*WeeklyContent{
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []ProposalContent{{
+				IssueNumber: 1, Title: "proposal: first", CurrentStatus: parser.StatusActive,
+				ChangedAt: changedAt.AddDate(0, 0, -7), CommentURL: "https://example.com/1",
+				Links: []Link{{Title: "proposal issue", URL: "https://github.com/golang/go/issues/1"}},
+			}},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []ProposalContent{{
+				IssueNumber: 2, Title: "proposal: second", PreviousStatus: parser.StatusLikelyAccept, CurrentStatus: parser.StatusAccepted,
+				ChangedAt: changedAt, UpdatedAt: changedAt.Add(time.Hour), CommentURL: "https://example.com/2", Reviewer: "rsc",
+				Summary: "## 概要\n\nAdds a new API.", SummaryLanguage: "en",
+				Links:   []Link{{Title: "proposal issue", URL: "https://github.com/golang/go/issues/2"}},
+				History: []StatusChange{{Status: parser.StatusLikelyAccept, ChangedAt: changedAt.AddDate(0, 0, -1)}, {Status: parser.StatusAccepted, ChangedAt: changedAt}},
+			}},
+		},
+	}
+	for _, wc := range input {
+		if err := mgr.WriteContent(wc); err != nil {
+			t.Fatalf("WriteContent() error = %v", err)
+		}
+	}
+
+	var out strings.Builder
+	if err := mgr.ExportJSON(&out); err != nil {
+		t.Fatalf("ExportJSON() error = %v", err)
+	}
+	for _, key := range []string{`"weeks"`, `"issue_number": 2`, `"current_status": "accepted"`, `"summary": "Adds a new API."`, `"links"`, `"history"`} {
+		if !strings.Contains(out.String(), key) {
+			t.Errorf("export should contain %s, got:\n%s", key, out.String())
+		}
+	}
+
+	got, err := ReadJSON(strings.NewReader(out.String()))
+	if err != nil {
+		t.Fatalf("ReadJSON() error = %v", err)
+	}
+	want, err := mgr.ListAllWeeks()
+	if err != nil {
+		t.Fatalf("ListAllWeeks() error = %v", err)
+	}
+	// Only the raw markdown is not exported
+	for _, wc := range want {
+		for i := range wc.Proposals {
+			wc.Proposals[i].FullContent = ""
+			wc.Proposals[i].Body = ""
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip mismatch:\ngot:  %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestCategoryFromTitle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{name: "nested package", title: "proposal: net/http: add X", want: "net/http"},
+		{name: "single package", title: "proposal: context: add AfterFunc", want: "context"},
+		{name: "several packages use the first", title: "proposal: bytes, strings: add Cut", want: "bytes"},
+		{name: "x repository", title: "proposal: x/tools/gopls: add check", want: "x/tools/gopls"},
+		{name: "without proposal prefix", title: "spec: allow X", want: "spec"},
+		{name: "bare proposal", title: "proposal: add Y", want: OtherCategory},
+		{name: "not a package", title: "proposal: Go 2: error handling", want: OtherCategory},
+		{name: "empty title", title: "", want: OtherCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := CategoryFromTitle(tt.title); got != tt.want {
+				t.Errorf("CategoryFromTitle(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripProposalPrefix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{name: "prefix with space", title: "proposal: net/http: add X", want: "net/http: add X"},
+		{name: "prefix without space", title: "proposal:net/http: add X", want: "net/http: add X"},
+		{name: "upper case prefix", title: "Proposal: spec: allow X", want: "spec: allow X"},
+		{name: "surrounding whitespace", title: "  proposal:   add Y  ", want: "add Y"},
+		{name: "no prefix", title: "spec: allow X", want: "spec: allow X"},
+		{name: "prefix inside title is kept", title: "x/tools: proposal: add Z", want: "x/tools: proposal: add Z"},
+		{name: "prefix only", title: "proposal:", want: ""},
+		{name: "empty title", title: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := StripProposalPrefix(tt.title); got != tt.want {
+				t.Errorf("StripProposalPrefix(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestManager_CategoryRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+	wc := mgr.PrepareContent([]parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: net/http: add X", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/1"},
+		{IssueNumber: 2, Title: "proposal: add Y", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/2"},
+	})
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(baseDir, weekDirPath(2026, 5), "proposal-1.md"))
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if !strings.Contains(string(data), "category: net/http\n") {
+		t.Errorf("frontmatter should record the category, got:\n%s", data)
+	}
+
+	got, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	want := map[int]string{1: "net/http", 2: OtherCategory}
+	for _, p := range got.Proposals {
+		if p.Category != want[p.IssueNumber] {
+			t.Errorf("#%d Category = %q, want %q", p.IssueNumber, p.Category, want[p.IssueNumber])
+		}
+	}
+}
+
+func TestManager_SummaryENRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	mgr := NewManager(WithBaseDir(baseDir))
+	wc := mgr.PrepareContent([]parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: sync: add X", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/1"},
+		{IssueNumber: 2, Title: "proposal: add Y", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/2"},
+	})
+	for i := range wc.Proposals {
+		wc.Proposals[i].Summary = "## 概要\n\n日本語の要約です。"
+	}
+	wc.Proposals[0].SummaryEN = "An English summary.\n\nSecond paragraph."
+	if err := mgr.WriteContent(wc); err != nil {
+		t.Fatalf("WriteContent() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(baseDir, weekDirPath(2026, 5), "proposal-1.md"))
+	if err != nil {
+		t.Fatalf("failed to read proposal file: %v", err)
+	}
+	if !strings.Contains(string(data), "## "+SummaryENHeading+"\n") {
+		t.Errorf("file should have an English summary section, got:\n%s", data)
+	}
+
+	// Read, rewrite and read again to check both summaries survive
+	for range 2 {
+		got, err := mgr.ReadExistingContent(2026, 5)
+		if err != nil {
+			t.Fatalf("ReadExistingContent() error = %v", err)
+		}
+		for _, p := range got.Proposals {
+			if p.Summary != "日本語の要約です。" {
+				t.Errorf("#%d Summary = %q", p.IssueNumber, p.Summary)
+			}
+			if strings.Contains(p.FullContent, "English") {
+				t.Errorf("#%d FullContent should not include the English summary, got %q", p.IssueNumber, p.FullContent)
+			}
+			want := ""
+			if p.IssueNumber == 1 {
+				want = "An English summary.\n\nSecond paragraph."
+			}
+			if p.SummaryEN != want {
+				t.Errorf("#%d SummaryEN = %q, want %q", p.IssueNumber, p.SummaryEN, want)
+			}
+		}
+		if err := mgr.WriteContent(got); err != nil {
+			t.Fatalf("WriteContent() error = %v", err)
+		}
+	}
+}
+
+func TestManager_WithLayout(t *testing.T) {
+	t.Parallel()
+
+	changes := []parser.ProposalChange{
+		{IssueNumber: 1, Title: "proposal: one", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/1"},
+		{IssueNumber: 2, Title: "proposal: two", CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2025, 12, 31, 12, 0, 0, 0, time.UTC), CommentURL: "https://example.com/2"},
+	}
+
+	tests := []struct {
+		name     string
+		layout   LayoutFunc
+		wantDirs []string
+	}{
+		{
+			name:     "flat",
+			layout:   FlatLayout,
+			wantDirs: []string{"2026-W05", "2026-W01"},
+		},
+		{
+			name:     "nested by default",
+			wantDirs: []string{"2026/W05", "2026/W01"},
+		},
+		{
+			name:     "custom",
+			layout:   func(year, week int) string { return fmt.Sprintf("weeks/%02d-of-%d", week, year) },
+			wantDirs: []string{"weeks/05-of-2026", "weeks/01-of-2026"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseDir := t.TempDir()
+			opts := []Option{WithBaseDir(baseDir)}
+			if tt.layout != nil {
+				opts = append(opts, WithLayout(tt.layout))
+			}
+			mgr := NewManager(opts...)
+
+			// Each change is in its own ISO week (2025-12-31 is in 2026-W01)
+			for _, change := range changes {
+				if err := mgr.WriteContent(mgr.PrepareContent([]parser.ProposalChange{change})); err != nil {
+					t.Fatalf("WriteContent() error = %v", err)
+				}
+			}
+			for i, dir := range tt.wantDirs {
+				path := filepath.Join(baseDir, filepath.FromSlash(dir), fmt.Sprintf("proposal-%d.md", changes[i].IssueNumber))
+				if _, err := os.Stat(path); err != nil {
+					t.Errorf("proposal file should be written to %s: %v", dir, err)
+				}
+			}
+
+			// Directories of other layouts are not picked up
+			if err := os.MkdirAll(filepath.Join(baseDir, "archive", "2024-W10"), 0o755); err != nil {
+				t.Fatalf("failed to create unrelated directory: %v", err)
+			}
+
+			weeks, err := mgr.ListAllWeeks()
+			if err != nil {
+				t.Fatalf("ListAllWeeks() error = %v", err)
+			}
+			var got []string
+			for _, w := range weeks {
+				for _, p := range w.Proposals {
+					got = append(got, fmt.Sprintf("%d-W%02d #%d", w.Year, w.Week, p.IssueNumber))
+				}
+			}
+			want := []string{"2026-W05 #1", "2026-W01 #2"}
+			if !slices.Equal(got, want) {
+				t.Errorf("ListAllWeeks() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestManager_ReadExistingContent_NormalizesTimezone(t *testing.T) {
+	t.Parallel()
+
+	// 2026-02-02 01:00 +09:00 is Monday of W06 locally but Sunday of W05 in UTC
+	baseDir := t.TempDir()
+	dir := filepath.Join(baseDir, weekDirPath(2026, 5))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	file := `---
+issue_number: 12345
+title: "proposal: hand-edited"
+previous_status: active
+current_status: likely_accept
+changed_at: 2026-02-02T01:00:00+09:00
+updated_at: 2026-02-02T09:30:00+09:00
+comment_url: https://github.com/golang/go/issues/33502#issuecomment-1
+related_issues: []
+---
+
+## 関連リンク
+`
+	if err := os.WriteFile(filepath.Join(dir, "proposal-12345.md"), []byte(file), 0o644); err != nil {
+		t.Fatalf("failed to write proposal file: %v", err)
+	}
+
+	mgr := NewManager(WithBaseDir(baseDir))
+	wc, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if wc == nil || len(wc.Proposals) != 1 {
+		t.Fatalf("ReadExistingContent() = %+v, want one proposal", wc)
+	}
+
+	p := wc.Proposals[0]
+	wantChangedAt := time.Date(2026, 2, 1, 16, 0, 0, 0, time.UTC)
+	if p.ChangedAt != wantChangedAt {
+		t.Errorf("ChangedAt = %v, want %v", p.ChangedAt, wantChangedAt)
+	}
+	if p.UpdatedAt.Location() != time.UTC {
+		t.Errorf("UpdatedAt location = %v, want UTC", p.UpdatedAt.Location())
+	}
+	if year, week := p.ChangedAt.ISOWeek(); year != 2026 || week != 5 {
+		t.Errorf("ChangedAt ISO week = %d-W%02d, want the UTC week 2026-W05", year, week)
+	}
+	if !p.ChangedThisWeek {
+		t.Error("proposal should be marked as changed in its UTC week")
+	}
+}
+
+func TestManager_ReadExistingContent_DuplicateIssue(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	dir := filepath.Join(baseDir, weekDirPath(2026, 5))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	file := `---
+issue_number: 12345
+title: "proposal: duplicated"
+previous_status: active
+current_status: likely_accept
+changed_at: 2026-01-30T12:00:00Z
+comment_url: https://github.com/golang/go/issues/33502#issuecomment-1
+related_issues: []
+---
+
+## 関連リンク
+`
+	for _, name := range []string{"proposal-12345.md", "proposal-12345-copy.md"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(file), 0o644); err != nil {
+			t.Fatalf("failed to write proposal file: %v", err)
+		}
+	}
+
+	mgr := NewManager(WithBaseDir(baseDir))
+	_, err := mgr.ReadExistingContent(2026, 5)
+	if err == nil {
+		t.Fatal("ReadExistingContent() should return an error for duplicate issue numbers")
+	}
+	for _, name := range []string{"proposal-12345.md", "proposal-12345-copy.md"} {
+		if !strings.Contains(err.Error(), filepath.Join(dir, name)) {
+			t.Errorf("error %q should mention %s", err, filepath.Join(dir, name))
+		}
+	}
+}
+
+func TestManager_ReadExistingContent_BOMAndCRLF(t *testing.T) {
+	t.Parallel()
+
+	baseDir := t.TempDir()
+	dir := filepath.Join(baseDir, weekDirPath(2026, 5))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	file := "\ufeff---\r\n" +
+		"issue_number: 12345\r\n" +
+		"title: \"proposal: windows\"\r\n" +
+		"previous_status: active\r\n" +
+		"current_status: likely_accept\r\n" +
+		"changed_at: 2026-01-30T12:00:00Z\r\n" +
+		"comment_url: https://github.com/golang/go/issues/33502#issuecomment-1\r\n" +
+		"related_issues: []\r\n" +
+		"---\r\n" +
+		"\r\n" +
+		"## 概要\r\n" +
+		"\r\n" +
+		"Windowsで書かれた要約です。\r\n"
+	if err := os.WriteFile(filepath.Join(dir, "proposal-12345.md"), []byte(file), 0o644); err != nil {
+		t.Fatalf("failed to write proposal file: %v", err)
+	}
+
+	mgr := NewManager(WithBaseDir(baseDir))
+	wc, err := mgr.ReadExistingContent(2026, 5)
+	if err != nil {
+		t.Fatalf("ReadExistingContent() error = %v", err)
+	}
+	if wc == nil || len(wc.Proposals) != 1 {
+		t.Fatalf("ReadExistingContent() = %+v, want one proposal", wc)
+	}
+
+	p := wc.Proposals[0]
+	if p.IssueNumber != 12345 || p.Title != "proposal: windows" {
+		t.Errorf("frontmatter = #%d %q, want #12345 %q", p.IssueNumber, p.Title, "proposal: windows")
+	}
+	if p.Summary != "Windowsで書かれた要約です。" {
+		t.Errorf("Summary = %q, want %q", p.Summary, "Windowsで書かれた要約です。")
+	}
+}
@@ -0,0 +1,112 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// DefaultArchiveDir is the directory, relative to the base directory, that
+// PruneOlderThan moves pruned weeks into unless configured otherwise.
+// It is not a year directory, so ListAllWeeks of the base directory skips it;
+// a Manager with WithBaseDir pointed at it lists the archived weeks.
+const DefaultArchiveDir = "archive"
+
+// WithArchiveDir sets the directory PruneOlderThan moves pruned weeks into,
+// keeping the week directory layout (see WithLayout). The default is DefaultArchiveDir
+// under the base directory.
+func WithArchiveDir(dir string) Option {
+	return func(m *Manager) {
+		m.archiveDir = dir
+	}
+}
+
+// WithPruneDelete makes PruneOlderThan delete pruned weeks instead of moving
+// them into the archive directory.
+func WithPruneDelete(enabled bool) Option {
+	return func(m *Manager) {
+		m.pruneDelete = enabled
+	}
+}
+
+// archivePath returns the directory pruned weeks are moved into.
+func (m *Manager) archivePath() string {
+	if m.archiveDir != "" {
+		return m.archiveDir
+	}
+	return filepath.Join(m.baseDir, DefaultArchiveDir)
+}
+
+// isoWeekStart returns the Monday 00:00 UTC that starts the given ISO week.
+func isoWeekStart(year, week int) time.Time {
+	// January 4th is always in ISO week 1
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7 // days since Monday
+	return jan4.AddDate(0, 0, -offset+(week-1)*7)
+}
+
+// PruneOlderThan moves the week directories whose week ended at or before
+// cutoff into the archive directory (see WithArchiveDir), or deletes them if
+// WithPruneDelete is set. The keep most recent weeks are never touched,
+// whatever their date. Nothing is changed if no week qualifies.
+// Returns the pruned weeks as paths in the layout (e.g. "YYYY/WXX"), oldest first.
+func (m *Manager) PruneOlderThan(cutoff time.Time, keep int) ([]string, error) {
+	keys, err := m.listWeekDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	// Newest first, so the first keep weeks are protected
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].year != keys[j].year {
+			return keys[i].year > keys[j].year
+		}
+		return keys[i].week > keys[j].week
+	})
+
+	var pruned []string
+	for i := len(keys) - 1; i >= max(keep, 0); i-- {
+		key := keys[i]
+		weekEnd := isoWeekStart(key.year, key.week).AddDate(0, 0, 7)
+		if weekEnd.After(cutoff) {
+			continue
+		}
+
+		rel := m.weekDir(key.year, key.week)
+		src := filepath.Join(m.baseDir, rel)
+		if m.pruneDelete {
+			if err := os.RemoveAll(src); err != nil {
+				return pruned, fmt.Errorf("failed to delete %s: %w", src, err)
+			}
+		} else {
+			dst := filepath.Join(m.archivePath(), rel)
+			if _, err := os.Stat(dst); err == nil {
+				return pruned, fmt.Errorf("failed to archive %s: %s already exists", src, dst)
+			} else if !errors.Is(err, fs.ErrNotExist) {
+				return pruned, fmt.Errorf("failed to access %s: %w", dst, err)
+			}
+			if err := os.MkdirAll(filepath.Dir(dst), dirPerm); err != nil {
+				return pruned, fmt.Errorf("failed to create archive directory: %w", err)
+			}
+			if err := os.Rename(src, dst); err != nil {
+				return pruned, fmt.Errorf("failed to archive %s: %w", src, err)
+			}
+		}
+		pruned = append(pruned, rel)
+
+		// Remove the year directory once its last week is gone
+		yearDir := filepath.Dir(src)
+		if yearDir == filepath.Clean(m.baseDir) {
+			continue
+		}
+		if entries, err := os.ReadDir(yearDir); err == nil && len(entries) == 0 {
+			_ = os.Remove(yearDir)
+		}
+	}
+
+	return pruned, nil
+}
@@ -0,0 +1,132 @@
+package content
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+// Transition is a single status change of a proposal within a week.
+// It keeps same-week intermediate transitions (e.g. likely_accept, then
+// accepted) that deduplication to the latest change would otherwise drop.
+type Transition struct {
+	ChangedAt      time.Time     `yaml:"changed_at"`
+	PreviousStatus parser.Status `yaml:"previous_status"`
+	CurrentStatus  parser.Status `yaml:"current_status"`
+	CommentURL     string        `yaml:"comment_url"`
+}
+
+// CollectTransitions groups changes by issue number, keeping one transition
+// per distinct (issue, status) pair: the earliest change to that status.
+// Transitions are sorted by ChangedAt (oldest first).
+func CollectTransitions(changes []parser.ProposalChange) map[int][]Transition {
+	result := make(map[int][]Transition)
+	for _, change := range changes {
+		result[change.IssueNumber] = append(result[change.IssueNumber], Transition{
+			ChangedAt:      change.ChangedAt,
+			PreviousStatus: change.PreviousStatus,
+			CurrentStatus:  change.CurrentStatus,
+			CommentURL:     change.CommentURL,
+		})
+	}
+	for issue, transitions := range result {
+		result[issue] = mergeTransitions(nil, transitions)
+	}
+	return result
+}
+
+// AttachTransitions sets the transitions of each proposal in content whose
+// issue has more than one distinct transition. The proposal itself still
+// reflects the latest change as its current status.
+func (m *Manager) AttachTransitions(content *WeeklyContent, transitions map[int][]Transition) {
+	if content == nil {
+		return
+	}
+	for i := range content.Proposals {
+		if t := transitions[content.Proposals[i].IssueNumber]; len(t) > 1 {
+			content.Proposals[i].Transitions = t
+		}
+	}
+}
+
+// mergeTransitions returns the union of existing and added transitions,
+// keeping the earliest transition to each status, sorted by ChangedAt.
+func mergeTransitions(existing, added []Transition) []Transition {
+	if len(existing) == 0 && len(added) == 0 {
+		return nil
+	}
+
+	all := append(append([]Transition{}, existing...), added...)
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].ChangedAt.Before(all[j].ChangedAt)
+	})
+
+	seen := make(map[parser.Status]bool, len(all))
+	merged := make([]Transition, 0, len(all))
+	for _, t := range all {
+		if seen[t.CurrentStatus] {
+			continue
+		}
+		seen[t.CurrentStatus] = true
+		merged = append(merged, t)
+	}
+	return merged
+}
+
+// writeTransitions writes the transitions frontmatter block, one flow
+// mapping per line. Nothing is written if there are no transitions.
+func writeTransitions(b *strings.Builder, transitions []Transition) {
+	if len(transitions) == 0 {
+		return
+	}
+	b.WriteString("transitions:\n")
+	for _, t := range transitions {
+		fmt.Fprintf(b, "  - {changed_at: %s, previous_status: %s, current_status: %s",
+			t.ChangedAt.UTC().Format(time.RFC3339), t.PreviousStatus, t.CurrentStatus)
+		if t.CommentURL != "" {
+			fmt.Fprintf(b, ", comment_url: %s", t.CommentURL)
+		}
+		b.WriteString("}\n")
+	}
+}
+
+// StatusChange is one entry of a proposal's status history: a status and
+// when the proposal entered it.
+type StatusChange struct {
+	ChangedAt time.Time     `yaml:"changed_at"`
+	Status    parser.Status `yaml:"status"`
+}
+
+// appendHistory returns the status history of existing extended with the
+// status of newProposal. A proposal without recorded history starts from
+// its existing current status. A status equal to the latest entry is not
+// appended again, and a history of a single status is returned as nil so
+// that rewriting an unchanged proposal does not add the key.
+func appendHistory(existing, newProposal ProposalContent) []StatusChange {
+	history := append([]StatusChange{}, existing.History...)
+	if len(history) == 0 && existing.CurrentStatus != "" {
+		history = append(history, StatusChange{ChangedAt: existing.ChangedAt, Status: existing.CurrentStatus})
+	}
+	if newProposal.CurrentStatus != "" && (len(history) == 0 || history[len(history)-1].Status != newProposal.CurrentStatus) {
+		history = append(history, StatusChange{ChangedAt: newProposal.ChangedAt, Status: newProposal.CurrentStatus})
+	}
+	if len(history) < 2 {
+		return nil
+	}
+	return history
+}
+
+// writeHistory writes the history frontmatter block, one flow mapping per
+// line. Nothing is written if there is no history.
+func writeHistory(b *strings.Builder, history []StatusChange) {
+	if len(history) == 0 {
+		return
+	}
+	b.WriteString("history:\n")
+	for _, h := range history {
+		fmt.Fprintf(b, "  - {status: %s, changed_at: %s}\n", h.Status, h.ChangedAt.UTC().Format(time.RFC3339))
+	}
+}
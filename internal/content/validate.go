@@ -0,0 +1,146 @@
+package content
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+// errMissingField is wrapped by ParseError when a required field is absent.
+var errMissingField = errors.New("missing required field")
+
+// ParseError describes why a proposal file could not be parsed or failed a
+// ProposalCheck.
+// Line is the 1-based line of the offending frontmatter entry, or zero when
+// the error concerns the whole file (e.g. a missing required field).
+type ParseError struct {
+	Err   error
+	Path  string
+	Field string
+	Line  int
+}
+
+// Error formats the error as "path:line: field: err".
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Path)
+	if e.Line > 0 {
+		fmt.Fprintf(&b, ":%d", e.Line)
+	}
+	b.WriteString(": ")
+	if e.Field != "" {
+		b.WriteString(e.Field)
+		b.WriteString(": ")
+	}
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError collects the parse and check errors of every invalid
+// proposal file found by ValidateAll.
+type ValidationError struct {
+	Errors []*ParseError
+}
+
+// Error lists the parse errors grouped by file, one line per error.
+func (e *ValidationError) Error() string {
+	byPath := make(map[string][]*ParseError)
+	var paths []string
+	for _, pe := range e.Errors {
+		if _, ok := byPath[pe.Path]; !ok {
+			paths = append(paths, pe.Path)
+		}
+		byPath[pe.Path] = append(byPath[pe.Path], pe)
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d invalid proposal file(s):", len(paths))
+	for _, path := range paths {
+		fmt.Fprintf(&b, "\n%s:", path)
+		for _, pe := range byPath[path] {
+			b.WriteString("\n  ")
+			if pe.Line > 0 {
+				fmt.Fprintf(&b, "line %d: ", pe.Line)
+			}
+			if pe.Field != "" {
+				b.WriteString(pe.Field)
+				b.WriteString(": ")
+			}
+			b.WriteString(pe.Err.Error())
+		}
+	}
+	return b.String()
+}
+
+// ProposalCheck is an additional check run by ValidateAll on every proposal
+// file that parses. It returns an error describing the problem, if any.
+type ProposalCheck func(p *ProposalContent) error
+
+// CheckSummaryLength is a ProposalCheck reporting summaries that are missing
+// or outside the recommended length (see ValidateSummaryLength).
+func CheckSummaryLength(p *ProposalContent) error {
+	if strings.TrimSpace(p.Summary) == "" {
+		return errors.New("summary is missing")
+	}
+	if ok, reason := ValidateSummaryLength(p.Summary); !ok {
+		return errors.New(reason)
+	}
+	return nil
+}
+
+// ValidateAll parses every proposal file in the content directory and
+// reports all invalid files at once, unlike ListAllWeeks which stops at the
+// first one. Files that parse are then passed to each of checks.
+// It returns a *ValidationError if any file fails to parse or a check.
+func (m *Manager) ValidateAll(checks ...ProposalCheck) error {
+	keys, err := m.listWeekDirs()
+	if err != nil {
+		return err
+	}
+
+	fsys := m.readFS()
+	var parseErrs []*ParseError
+	for _, key := range keys {
+		dirPath := m.readPath(m.baseDir, m.weekDir(key.year, key.week))
+		entries, err := fs.ReadDir(fsys, dirPath)
+		if err != nil {
+			return fmt.Errorf("failed to read directory %s: %w", dirPath, err)
+		}
+
+		for _, entry := range entries {
+			if !isProposalEntry(entry) {
+				continue
+			}
+
+			filePath := m.readPath(dirPath, entry.Name())
+			p, err := parseProposalFile(fsys, filePath, m.headings)
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					pe = &ParseError{Path: filePath, Err: err}
+				}
+				parseErrs = append(parseErrs, pe)
+				continue
+			}
+
+			for _, check := range checks {
+				if err := check(p); err != nil {
+					parseErrs = append(parseErrs, &ParseError{Path: filePath, Err: err})
+				}
+			}
+		}
+	}
+
+	if len(parseErrs) > 0 {
+		return &ValidationError{Errors: parseErrs}
+	}
+	return nil
+}
@@ -282,7 +282,7 @@ func TestE2E_PipelineExecution_WithFallback(t *testing.T) {
 
 	// Integrate (no-op) and apply fallback
 	_ = contentSetup.Manager.IntegrateSummaries(weeklyContent, summaries)
-	_ = contentSetup.Manager.ApplyFallback(weeklyContent)
+	_, _ = contentSetup.Manager.ApplyFallback(weeklyContent)
 
 	// Write and generate
 	if err := contentSetup.Manager.WriteContent(weeklyContent); err != nil {
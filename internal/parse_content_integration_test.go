@@ -443,7 +443,7 @@ func TestIntegration_ParseToContent_WithFallback(t *testing.T) {
 
 	// Integrate (no-op) and apply fallback
 	_ = mgr.IntegrateSummaries(weeklyContent, summaries)
-	_ = mgr.ApplyFallback(weeklyContent)
+	_, _ = mgr.ApplyFallback(weeklyContent)
 
 	// Verify fallback was applied
 	for _, p := range weeklyContent.Proposals {
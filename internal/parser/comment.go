@@ -0,0 +1,193 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CommentFieldMapping names the JSON fields of a comment object in the API
+// response. Empty fields fall back to the GitHub field names, so the zero
+// value decodes GitHub responses. This allows GitHub-compatible forges such
+// as Gitea to be used even when their field names differ.
+type CommentFieldMapping struct {
+	ID        string
+	Body      string
+	CreatedAt string
+	UpdatedAt string
+	HTMLURL   string
+}
+
+// withDefaults returns the mapping with empty fields set to the GitHub names.
+func (m CommentFieldMapping) withDefaults() CommentFieldMapping {
+	if m.ID == "" {
+		m.ID = "id"
+	}
+	if m.Body == "" {
+		m.Body = "body"
+	}
+	if m.CreatedAt == "" {
+		m.CreatedAt = "created_at"
+	}
+	if m.UpdatedAt == "" {
+		m.UpdatedAt = "updated_at"
+	}
+	if m.HTMLURL == "" {
+		m.HTMLURL = "html_url"
+	}
+	return m
+}
+
+// decodeComments decodes an API response into comments.
+// Missing or null fields are left at their zero value instead of failing,
+// and comments without a URL get one built from the comment URL template.
+func (ip *IssueParser) decodeComments(r io.Reader) ([]GitHubComment, error) {
+	var raw []map[string]json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	ip.pagesFetched.Add(1)
+
+	fields := ip.commentFields.withDefaults()
+	comments := make([]GitHubComment, 0, len(raw))
+	for i, obj := range raw {
+		var c GitHubComment
+		var err error
+
+		if c.ID, err = decodeInt64Field(obj[fields.ID]); err != nil {
+			return nil, fmt.Errorf("comment %d: field %q: %w", i, fields.ID, err)
+		}
+		if c.Body, err = decodeStringField(obj[fields.Body]); err != nil {
+			return nil, fmt.Errorf("comment %d: field %q: %w", i, fields.Body, err)
+		}
+		if c.CreatedAt, err = decodeTimeField(obj[fields.CreatedAt]); err != nil {
+			return nil, fmt.Errorf("comment %d: field %q: %w", i, fields.CreatedAt, err)
+		}
+		if c.UpdatedAt, err = decodeTimeField(obj[fields.UpdatedAt]); err != nil {
+			return nil, fmt.Errorf("comment %d: field %q: %w", i, fields.UpdatedAt, err)
+		}
+		if c.HTMLURL, err = decodeStringField(obj[fields.HTMLURL]); err != nil {
+			return nil, fmt.Errorf("comment %d: field %q: %w", i, fields.HTMLURL, err)
+		}
+
+		if c.HTMLURL == "" {
+			c.HTMLURL = ip.commentURL(c.ID)
+		}
+
+		comments = append(comments, c)
+	}
+
+	return comments, nil
+}
+
+// commentURL builds a comment URL from the configured template by replacing
+// {issue} and {id}. It returns an empty string if no template is configured.
+func (ip *IssueParser) commentURL(id int64) string {
+	if ip.commentURLTemplate == "" {
+		return ""
+	}
+	return strings.NewReplacer(
+		"{issue}", strconv.Itoa(ip.issueNumber),
+		"{id}", strconv.FormatInt(id, 10),
+	).Replace(ip.commentURLTemplate)
+}
+
+// isNull reports whether a raw JSON value is absent or null.
+func isNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// decodeStringField decodes a JSON string, treating absent values as empty.
+func decodeStringField(raw json.RawMessage) (string, error) {
+	if isNull(raw) {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// decodeInt64Field decodes a JSON number or numeric string, treating absent
+// values as zero.
+func decodeInt64Field(raw json.RawMessage) (int64, error) {
+	if isNull(raw) {
+		return 0, nil
+	}
+	var n int64
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n, nil
+	}
+	s, err := decodeStringField(raw)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// decodeTimeField decodes an RFC 3339 timestamp, treating absent or empty
+// values as the zero time.
+func decodeTimeField(raw json.RawMessage) (time.Time, error) {
+	s, err := decodeStringField(raw)
+	if err != nil || s == "" {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// DefaultMaxCommentBodySize is the default maximum comment body size (1 MiB).
+const DefaultMaxCommentBodySize = 1 << 20
+
+// commentBody returns the body of c to parse, enforcing the configured size
+// limit. Oversized bodies are truncated at the last line break within the
+// limit, or skipped (ok is false) unless truncation is enabled.
+// A nil comment is reported as not ok.
+func (ip *IssueParser) commentBody(c *GitHubComment) (body string, ok bool) {
+	if c == nil {
+		return "", false
+	}
+	if ip.maxBodySize < 0 || len(c.Body) <= ip.maxBodySize {
+		return c.Body, true
+	}
+
+	if !ip.truncateOversized {
+		ip.logger.Warn("skipping oversized comment",
+			"commentId", c.ID,
+			"size", len(c.Body),
+			"maxSize", ip.maxBodySize)
+		return "", false
+	}
+
+	ip.logger.Warn("truncating oversized comment",
+		"commentId", c.ID,
+		"size", len(c.Body),
+		"maxSize", ip.maxBodySize)
+	body = c.Body[:ip.maxBodySize]
+	if i := strings.LastIndexByte(body, '\n'); i >= 0 {
+		body = body[:i+1]
+	} else {
+		body = strings.ToValidUTF8(body, "")
+	}
+	return body, true
+}
+
+// minutesBody returns the body of c to parse like commentBody, and reports
+// false for comments that are not minutes (see isMinutesComment) so that
+// lookalike bullets in ordinary comments are never parsed as changes.
+func (ip *IssueParser) minutesBody(c *GitHubComment) (body string, ok bool) {
+	body, ok = ip.commentBody(c)
+	if !ok {
+		return "", false
+	}
+	if !isMinutesComment(body) {
+		ip.logger.Info("skipping non-minutes comment",
+			"commentId", c.ID,
+			"comment_preview", truncate(body, commentPreviewLength))
+		return "", false
+	}
+	return body, true
+}
@@ -308,8 +308,10 @@ func TestIntegration_MultiPageFlow(t *testing.T) {
 			// First page request or fetchPreviousComment request (no page param for fetchPreviousComment)
 			if pageParam == "1" {
 				paginationRequests.Add(1)
+				// rel="next" announces the second page
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next", <http://%s%s?page=2>; rel="last"`, r.Host, r.URL.Path, r.Host, r.URL.Path))
 			}
-			// First page: 100 comments (triggers pagination)
+			// First page: 100 comments
 			for i := range 100 {
 				comments = append(comments, map[string]any{
 					"id":         int64(1000 + i),
@@ -682,3 +684,105 @@ func TestIntegration_NoChangesFlow(t *testing.T) {
 		t.Errorf("expected 0 changes in output, got %d", len(output.Changes))
 	}
 }
+
+// TestIntegration_SinceOverridesState tests that an explicit Since earlier
+// than the saved state re-fetches comments that were already processed,
+// without moving the state backwards.
+func TestIntegration_SinceOverridesState(t *testing.T) {
+	t.Parallel()
+
+	base := time.Now().Add(-24 * time.Hour).Truncate(time.Second).UTC()
+	comments := []map[string]any{
+		{
+			"id":         int64(1001),
+			"body":       "**2026-01-30** / **@rsc**\n\n- [#11111](https://github.com/golang/go/issues/11111) **proposal: older**\n  - **accepted**\n",
+			"created_at": base.Format(time.RFC3339),
+			"updated_at": base.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-1001",
+		},
+		{
+			"id":         int64(1002),
+			"body":       "**2026-01-31** / **@rsc**\n\n- [#22222](https://github.com/golang/go/issues/22222) **proposal: newer**\n  - **declined**\n",
+			"created_at": base.Add(time.Hour).Format(time.RFC3339),
+			"updated_at": base.Add(time.Hour).Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-1002",
+		},
+	}
+
+	// Mock server honoring the since parameter like the GitHub API
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		since, err := time.Parse(time.RFC3339, r.URL.Query().Get("since"))
+		if err != nil {
+			t.Errorf("invalid since parameter: %q", r.URL.Query().Get("since"))
+		}
+
+		var filtered []map[string]any
+		for _, c := range comments {
+			updatedAt, _ := time.Parse(time.RFC3339, c["updated_at"].(string))
+			if !updatedAt.Before(since) {
+				filtered = append(filtered, c)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(filtered)
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+	sm := NewStateManager(statePath)
+	saved := &State{
+		LastProcessedAt: base.Add(time.Hour),
+		LastCommentID:   "1002",
+	}
+	if err := sm.SaveState(saved); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+
+	newParser := func(since time.Time) *IssueParser {
+		ip, err := NewIssueParser(IssueParserConfig{
+			StateManager: sm,
+			BaseURL:      server.URL,
+			Token:        "test-token",
+			Since:        since,
+		})
+		if err != nil {
+			t.Fatalf("failed to create IssueParser: %v", err)
+		}
+		return ip
+	}
+
+	ctx := context.Background()
+
+	// Without Since, both comments were already processed
+	changes, err := newParser(time.Time{}).FetchChanges(ctx)
+	if err != nil {
+		t.Fatalf("FetchChanges() error = %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes without since, got %d", len(changes))
+	}
+
+	// With Since before both comments, both are processed again
+	changes, err = newParser(base.Add(-time.Minute)).FetchChanges(ctx)
+	if err != nil {
+		t.Fatalf("FetchChanges() with since error = %v", err)
+	}
+	got := make(map[int]bool)
+	for _, c := range changes {
+		got[c.IssueNumber] = true
+	}
+	if !got[11111] || !got[22222] {
+		t.Errorf("expected changes for #11111 and #22222 with since, got %+v", changes)
+	}
+
+	// The state is not moved backwards
+	state, err := sm.LoadState()
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if state.LastCommentID != "1002" || !state.LastProcessedAt.Equal(saved.LastProcessedAt) {
+		t.Errorf("state = %s at %v, want 1002 at %v", state.LastCommentID, state.LastProcessedAt, saved.LastProcessedAt)
+	}
+}
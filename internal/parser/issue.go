@@ -9,9 +9,14 @@ import (
 	"io/fs"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -23,11 +28,17 @@ const (
 	// ProposalReviewIssueNumber is the issue number for the proposal review minutes.
 	ProposalReviewIssueNumber = 33502
 
+	// defaultOwner and defaultRepo identify the repository of the proposal
+	// review minutes issue.
+	defaultOwner = "golang"
+	defaultRepo  = "go"
+
 	// defaultBaseURL is the default GitHub API base URL.
 	defaultBaseURL = "https://api.github.com"
 
-	// perPage is the number of comments to fetch per request.
-	perPage = 100
+	// MaxPerPage is the largest page size accepted by the GitHub API, and the
+	// default number of comments fetched per request.
+	MaxPerPage = 100
 
 	// httpClientTimeout is the timeout for HTTP requests.
 	httpClientTimeout = 30 * time.Second
@@ -36,12 +47,81 @@ const (
 // ErrNilStateManager is returned when StateManager is nil.
 var ErrNilStateManager = errors.New("StateManager is required")
 
+// ErrInvalidIssueNumber is returned when IssueNumber is negative, or zero
+// while Owner or Repo is set.
+var ErrInvalidIssueNumber = errors.New("IssueNumber must be positive when Owner or Repo is set")
+
+// ErrInvalidBaseURL is returned when BaseURL is not an absolute http or
+// https URL.
+var ErrInvalidBaseURL = errors.New("BaseURL must be an absolute http or https URL")
+
 // IssueParserConfig holds configuration for IssueParser.
 type IssueParserConfig struct {
 	StateManager *StateManager
 	Logger       *slog.Logger
-	BaseURL      string
-	Token        string
+	// BaseURL is the API root, e.g. "https://git.example.com/api/v3" for
+	// GitHub Enterprise. A path prefix is kept and request paths are joined
+	// to it. It defaults to https://api.github.com.
+	BaseURL string
+	Token   string
+	// Owner and Repo name the repository of the tracking issue whose comments
+	// hold the minutes. They default to golang/go.
+	Owner string
+	Repo  string
+	// IssueNumber is the tracking issue number. It defaults to
+	// ProposalReviewIssueNumber, and is required if Owner or Repo is set.
+	IssueNumber int
+	// CommentFields overrides the JSON field names used to decode comments.
+	// The zero value uses the GitHub field names.
+	CommentFields CommentFieldMapping
+	// CommentURLTemplate builds a comment URL when the response has none
+	// (e.g. Gitea without html_url). "{issue}" and "{id}" are replaced with
+	// the issue number and comment ID. If empty, such URLs are left empty.
+	CommentURLTemplate string
+	// MaxCommentBodySize is the maximum comment body size in bytes that is
+	// parsed. Zero uses DefaultMaxCommentBodySize; a negative value disables
+	// the limit.
+	MaxCommentBodySize int
+	// TruncateOversizedComments parses the first MaxCommentBodySize bytes of
+	// an oversized comment instead of skipping it.
+	TruncateOversizedComments bool
+	// MaxConcurrentRequests limits the number of GitHub requests in flight at
+	// once across all callers of the parser. Zero or negative means unlimited.
+	MaxConcurrentRequests int
+	// RequestDelay is a politeness delay between the starts of consecutive
+	// GitHub requests, including paginated requests and retries.
+	// Zero disables the delay.
+	RequestDelay time.Duration
+	// MaxRetries is the number of times a request failing with 429, 500 or
+	// 503 is retried. Zero disables retries.
+	MaxRetries int
+	// RetryBaseDelay is the wait before the first retry when the response has
+	// no Retry-After header; it doubles on each further retry. Zero uses
+	// DefaultRetryBaseDelay.
+	RetryBaseDelay time.Duration
+	// Concurrency is the number of comment pages fetched in parallel once the
+	// page count is known from the Link header. Zero or one fetches pages
+	// serially. MaxConcurrentRequests still caps the requests in flight.
+	Concurrency int
+	// DryRun fetches and parses changes without saving the state, so that the
+	// same comments are processed again on the next run.
+	DryRun bool
+	// DeferStateSave makes FetchChanges keep the advanced state in memory
+	// instead of saving it; SaveState saves it later. This lets a caller
+	// advance the state only once the changes have been stored.
+	DeferStateSave bool
+	// PerPage is the number of comments requested per page when fetching new
+	// comments. Zero uses MaxPerPage; larger values are clamped to it.
+	PerPage int
+	// IncludeRaw sets ProposalChange.RawComment to the full body of the
+	// minutes comment each change was extracted from, so that changes can be
+	// re-parsed offline. It considerably enlarges the changes file.
+	IncludeRaw bool
+	// Since, if non-zero, overrides the state's LastProcessedAt for this run:
+	// comments updated at or after Since are fetched, even if they were
+	// processed before. The state is only advanced if the run processes
+	// comments newer than the saved cursor.
+	Since time.Time
 }
 
 // IssueParser fetches and parses proposal changes from GitHub issue comments.
@@ -52,7 +132,41 @@ type IssueParser struct {
 	httpClient    *http.Client
 	baseURL       string
 	token         string
+	owner         string
+	repo          string
+	issueNumber   int
 	etag          string
+	// sleep waits between retries; replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+	// throttle applies the concurrency limit and politeness delay.
+	throttle *throttle
+	// maxRetries and retryBaseDelay control retries of transient errors.
+	maxRetries     int
+	retryBaseDelay time.Duration
+	// pagesFetched counts the comment pages received from the API.
+	pagesFetched atomic.Int64
+	// lastCommentID is the ID of the newest comment processed by the last
+	// FetchChanges call, or the saved cursor if there was none.
+	lastCommentID string
+	// dryRun skips saving the state.
+	dryRun bool
+	// deferSave holds the state to save in pendingState until SaveState.
+	deferSave    bool
+	pendingState *State
+	// since overrides the saved cursor when non-zero.
+	since time.Time
+	// perPage is the page size of comment requests.
+	perPage int
+	// includeRaw records the comment body in each change.
+	includeRaw bool
+	// concurrency is the number of pages fetched in parallel.
+	concurrency int
+
+	commentFields      CommentFieldMapping
+	commentURLTemplate string
+
+	maxBodySize       int
+	truncateOversized bool
 }
 
 // GitHubComment represents a GitHub issue comment.
@@ -77,9 +191,28 @@ func NewIssueParser(config IssueParserConfig) (*IssueParser, error) {
 		return nil, ErrNilStateManager
 	}
 
-	baseURL := config.BaseURL
-	if baseURL == "" {
-		baseURL = defaultBaseURL
+	baseURL, err := normalizeBaseURL(config.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, repo, issueNumber := config.Owner, config.Repo, config.IssueNumber
+	if issueNumber < 0 || (issueNumber == 0 && (owner != "" || repo != "")) {
+		return nil, ErrInvalidIssueNumber
+	}
+	if owner == "" {
+		owner = defaultOwner
+	}
+	if repo == "" {
+		repo = defaultRepo
+	}
+	if issueNumber == 0 {
+		issueNumber = ProposalReviewIssueNumber
+	}
+
+	retryBaseDelay := config.RetryBaseDelay
+	if retryBaseDelay <= 0 {
+		retryBaseDelay = DefaultRetryBaseDelay
 	}
 
 	logger := config.Logger
@@ -87,13 +220,43 @@ func NewIssueParser(config IssueParserConfig) (*IssueParser, error) {
 		logger = slog.Default()
 	}
 
+	perPage := config.PerPage
+	if perPage <= 0 || perPage > MaxPerPage {
+		perPage = MaxPerPage
+	}
+
+	maxBodySize := config.MaxCommentBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultMaxCommentBodySize
+	}
+
 	return &IssueParser{
 		stateManager:  config.StateManager,
 		minutesParser: NewMinutesParserWithLogger(logger),
 		baseURL:       baseURL,
 		token:         config.Token,
+		owner:         owner,
+		repo:          repo,
+		issueNumber:   issueNumber,
 		logger:        logger,
 		httpClient:    &http.Client{Timeout: httpClientTimeout},
+		sleep:         sleepContext,
+		throttle:      newThrottle(config.MaxConcurrentRequests, config.RequestDelay),
+
+		maxRetries:     config.MaxRetries,
+		retryBaseDelay: retryBaseDelay,
+		dryRun:         config.DryRun,
+		deferSave:      config.DeferStateSave,
+		concurrency:    config.Concurrency,
+		since:          config.Since,
+		perPage:        perPage,
+		includeRaw:     config.IncludeRaw,
+
+		commentFields:      config.CommentFields,
+		commentURLTemplate: config.CommentURLTemplate,
+
+		maxBodySize:       maxBodySize,
+		truncateOversized: config.TruncateOversizedComments,
 	}, nil
 }
 
@@ -114,9 +277,27 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 		return nil, fmt.Errorf("failed to load state: %w", err)
 	}
 
+	// Send the ETag persisted by the previous run with the first page request.
+	// An explicit window must be fetched even if nothing changed since then.
+	if state.ETag != "" && ip.since.IsZero() {
+		if ValidETag(state.ETag) {
+			ip.etag = state.ETag
+		} else {
+			ip.logger.Warn("ignoring malformed ETag in state", "etag", state.ETag)
+		}
+	}
+
 	var newComments []GitHubComment
+	ip.lastCommentID = state.LastCommentID
+	ip.pendingState = nil
+
+	since := state.LastProcessedAt
+	lastCommentID, _ := strconv.ParseInt(state.LastCommentID, 10, 64)
+	if !ip.since.IsZero() {
+		since, lastCommentID = ip.since, 0
+	}
 
-	if state.IsFresh {
+	if state.IsFresh && ip.since.IsZero() {
 		// Fresh state: only fetch the latest comment
 		ip.logger.Info("fresh state detected, fetching only latest comment")
 
@@ -132,13 +313,13 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 
 		ip.logger.Info("found latest comment", "count", len(newComments))
 	} else {
-		// Existing state: fetch comments since last processed
+		// Existing state or explicit window: fetch comments since the cursor
 		ip.logger.Info("fetching comments since last processed",
-			"since", state.LastProcessedAt,
-			"lastCommentId", state.LastCommentID)
+			"since", since,
+			"lastCommentId", lastCommentID)
 
 		// Fetch comments from GitHub API
-		comments, err := ip.fetchComments(ctx, state.LastProcessedAt)
+		comments, err := ip.fetchComments(ctx, since)
 		if err != nil {
 			ip.logger.Error("failed to fetch comments", "error", err)
 			return nil, fmt.Errorf("failed to fetch comments: %w", err)
@@ -146,7 +327,6 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 
 		// Filter out already processed comments using both timestamp and ID
 		// GitHub's 'since' parameter uses updated_at, so we filter by UpdatedAt
-		lastCommentID, _ := strconv.ParseInt(state.LastCommentID, 10, 64)
 		for _, c := range comments {
 			// Use UpdatedAt for filtering since GitHub API's 'since' is based on updated_at
 			effectiveTime := c.UpdatedAt
@@ -155,11 +335,11 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 			}
 
 			// Skip if comment is older than last processed
-			if effectiveTime.Before(state.LastProcessedAt) {
+			if effectiveTime.Before(since) {
 				continue
 			}
 			// Skip if comment has same timestamp but ID <= lastCommentID (already processed)
-			if effectiveTime.Equal(state.LastProcessedAt) && c.ID <= lastCommentID {
+			if effectiveTime.Equal(since) && c.ID <= lastCommentID {
 				continue
 			}
 			newComments = append(newComments, c)
@@ -169,6 +349,9 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 	}
 
 	if len(newComments) == 0 {
+		if err := ip.saveETag(state); err != nil {
+			return nil, err
+		}
 		return []ProposalChange{}, nil
 	}
 
@@ -202,9 +385,10 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 		ip.logger.Info("fetched previous comment for baseline",
 			"commentId", prevComment.ID,
 			"createdAt", prevComment.CreatedAt)
-
+	}
+	if prevBody, ok := ip.minutesBody(prevComment); ok {
 		// Parse the previous comment to extract proposal statuses
-		prevChanges, err := ip.minutesParser.Parse(prevComment.Body, prevComment.CreatedAt)
+		prevChanges, err := ip.minutesParser.Parse(prevBody, prevComment.CreatedAt)
 		if err != nil {
 			ip.logger.Warn("failed to parse previous comment",
 				"commentId", prevComment.ID,
@@ -224,17 +408,23 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 	var latestTime time.Time
 
 	for _, comment := range newComments {
-		changes, err := ip.minutesParser.Parse(comment.Body, comment.CreatedAt)
-		if err != nil {
-			ip.logger.Warn("failed to parse comment",
-				"commentId", comment.ID,
-				"error", err)
-			continue
+		var changes []ProposalChange
+		if body, ok := ip.minutesBody(&comment); ok {
+			changes, err = ip.minutesParser.Parse(body, comment.CreatedAt)
+			if err != nil {
+				ip.logger.Warn("failed to parse comment",
+					"commentId", comment.ID,
+					"error", err)
+				continue
+			}
 		}
 
 		// Process each change: set PreviousStatus and filter unchanged
 		for i := range changes {
 			changes[i].CommentURL = comment.HTMLURL
+			if ip.includeRaw {
+				changes[i].RawComment = comment.Body
+			}
 
 			issueNum := changes[i].IssueNumber
 			prevStatus, exists := proposalStatuses[issueNum]
@@ -268,16 +458,28 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 		}
 	}
 
-	// Update state with the latest processed comment (no ProposalStatuses needed)
 	if latestCommentID != 0 {
+		ip.lastCommentID = strconv.FormatInt(latestCommentID, 10)
+	}
+
+	// Update state with the latest processed comment (no ProposalStatuses needed)
+	if latestCommentID != 0 && ip.dryRun {
+		ip.logger.Info("dry run, leaving state unchanged",
+			"lastCommentId", latestCommentID,
+			"lastProcessedAt", latestTime)
+	} else if latestCommentID != 0 && !ip.advancesState(state, latestTime, latestCommentID) {
+		ip.logger.Info("since window does not extend past saved state, leaving state unchanged",
+			"lastCommentId", latestCommentID,
+			"lastProcessedAt", latestTime)
+	} else if latestCommentID != 0 {
 		state.LastProcessedAt = latestTime
-		state.LastCommentID = strconv.FormatInt(latestCommentID, 10)
+		state.LastCommentID = ip.lastCommentID
 		state.ProposalStatuses = nil // Clear to avoid saving to state.json (uses omitempty)
+		state.ETag = ip.etag
 		state.IsFresh = false
 
-		if err := ip.stateManager.SaveState(state); err != nil {
-			ip.logger.Error("failed to save state", "error", err)
-			return nil, fmt.Errorf("failed to save state: %w", err)
+		if err := ip.saveState(state); err != nil {
+			return nil, err
 		}
 	}
 
@@ -286,16 +488,91 @@ func (ip *IssueParser) FetchChanges(ctx context.Context) ([]ProposalChange, erro
 	return allChanges, nil
 }
 
+// saveETag persists the ETag of the latest 200 response when no new comments
+// advanced the state, so that the next run can send a conditional request.
+func (ip *IssueParser) saveETag(state *State) error {
+	if ip.dryRun || state.IsFresh || !ip.since.IsZero() || ip.etag == state.ETag {
+		return nil
+	}
+
+	state.ETag = ip.etag
+	state.ProposalStatuses = nil // Clear to avoid saving to state.json (uses omitempty)
+	return ip.saveState(state)
+}
+
+// saveState saves state, or keeps it for SaveState with DeferStateSave.
+func (ip *IssueParser) saveState(state *State) error {
+	if ip.deferSave {
+		ip.pendingState = state
+		return nil
+	}
+	if err := ip.stateManager.SaveState(state); err != nil {
+		ip.logger.Error("failed to save state", "error", err)
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	return nil
+}
+
+// SaveState saves the state advanced by the last FetchChanges call under
+// DeferStateSave. It does nothing if that call left the state unchanged.
+func (ip *IssueParser) SaveState() error {
+	if ip.pendingState == nil {
+		return nil
+	}
+	if err := ip.stateManager.SaveState(ip.pendingState); err != nil {
+		ip.logger.Error("failed to save state", "error", err)
+		return fmt.Errorf("failed to save state: %w", err)
+	}
+	ip.pendingState = nil
+	return nil
+}
+
+// advancesState reports whether the comment processed last, identified by its
+// effective time and ID, is newer than the saved cursor. It is always true
+// without an explicit Since, which only fetches newer comments.
+func (ip *IssueParser) advancesState(state *State, latestTime time.Time, latestCommentID int64) bool {
+	if ip.since.IsZero() || state.IsFresh {
+		return true
+	}
+	lastCommentID, _ := strconv.ParseInt(state.LastCommentID, 10, 64)
+	return latestTime.After(state.LastProcessedAt) ||
+		(latestTime.Equal(state.LastProcessedAt) && latestCommentID > lastCommentID)
+}
+
+// normalizeBaseURL validates the API base URL and removes trailing and
+// duplicate slashes from its path, keeping any path prefix such as the
+// "/api/v3" of GitHub Enterprise. An empty base selects defaultBaseURL.
+func normalizeBaseURL(base string) (string, error) {
+	if base == "" {
+		return defaultBaseURL, nil
+	}
+	u, err := url.Parse(base)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidBaseURL, base)
+	}
+	u.Path = strings.TrimSuffix(path.Clean("/"+u.Path), "/")
+	u.RawPath = ""
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// commentsURL returns the API URL listing the tracking issue's comments with
+// the given query string.
+func (ip *IssueParser) commentsURL(query string) string {
+	return fmt.Sprintf("%s/repos/%s/%s/issues/%d/comments?%s",
+		ip.baseURL, url.PathEscape(ip.owner), url.PathEscape(ip.repo), ip.issueNumber, query)
+}
+
 // fetchPreviousComment retrieves the comment immediately before the specified comment ID.
 // This is used to establish the baseline proposal statuses for diff calculation.
 func (ip *IssueParser) fetchPreviousComment(ctx context.Context, beforeCommentID int64) (*GitHubComment, error) {
 	// Fetch recent comments and find the one before the specified ID
 	// We fetch from the last 30 days to ensure we get enough history
 	since := time.Now().AddDate(0, 0, -30)
-	url := fmt.Sprintf("%s/repos/golang/go/issues/%d/comments?per_page=100&since=%s",
-		ip.baseURL, ProposalReviewIssueNumber, since.Format(time.RFC3339))
+	reqURL := ip.commentsURL(fmt.Sprintf("per_page=%d&since=%s", MaxPerPage, since.Format(time.RFC3339)))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -307,7 +584,7 @@ func (ip *IssueParser) fetchPreviousComment(ctx context.Context, beforeCommentID
 		req.Header.Set("Authorization", "Bearer "+ip.token)
 	}
 
-	resp, err := ip.httpClient.Do(req)
+	resp, err := ip.doRequest(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -318,8 +595,8 @@ func (ip *IssueParser) fetchPreviousComment(ctx context.Context, beforeCommentID
 		return nil, fmt.Errorf("GitHub API error: status=%d body=%s", resp.StatusCode, string(body))
 	}
 
-	var comments []GitHubComment
-	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
+	comments, err := ip.decodeComments(resp.Body)
+	if err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -340,10 +617,9 @@ func (ip *IssueParser) fetchPreviousComment(ctx context.Context, beforeCommentID
 func (ip *IssueParser) fetchLatestComment(ctx context.Context) (*GitHubComment, error) {
 	// Fetch comments from the last 7 days
 	since := time.Now().AddDate(0, 0, -7)
-	url := fmt.Sprintf("%s/repos/golang/go/issues/%d/comments?per_page=100&since=%s",
-		ip.baseURL, ProposalReviewIssueNumber, since.Format(time.RFC3339))
+	reqURL := ip.commentsURL(fmt.Sprintf("per_page=%d&since=%s", MaxPerPage, since.Format(time.RFC3339)))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -355,7 +631,7 @@ func (ip *IssueParser) fetchLatestComment(ctx context.Context) (*GitHubComment,
 		req.Header.Set("Authorization", "Bearer "+ip.token)
 	}
 
-	resp, err := ip.httpClient.Do(req)
+	resp, err := ip.doRequest(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
@@ -366,8 +642,8 @@ func (ip *IssueParser) fetchLatestComment(ctx context.Context) (*GitHubComment,
 		return nil, fmt.Errorf("GitHub API error: status=%d body=%s", resp.StatusCode, string(body))
 	}
 
-	var comments []GitHubComment
-	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
+	comments, err := ip.decodeComments(resp.Body)
+	if err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
@@ -380,35 +656,101 @@ func (ip *IssueParser) fetchLatestComment(ctx context.Context) (*GitHubComment,
 }
 
 // fetchComments retrieves comments from the GitHub API with pagination.
+// Once the first page reveals the last page number through the Link header,
+// the remaining pages are fetched in parallel when concurrency is above one.
 func (ip *IssueParser) fetchComments(ctx context.Context, since time.Time) ([]GitHubComment, error) {
-	var allComments []GitHubComment
-	page := 1
+	first, err := ip.fetchCommentsPage(ctx, since, 1)
+	if err != nil {
+		return nil, err
+	}
 
-	for {
-		comments, hasMore, err := ip.fetchCommentsPage(ctx, since, page)
+	if ip.concurrency > 1 && first.lastPage > 1 {
+		rest, err := ip.fetchPagesConcurrently(ctx, since, 2, first.lastPage)
 		if err != nil {
 			return nil, err
 		}
+		return append(first.comments, rest...), nil
+	}
 
-		allComments = append(allComments, comments...)
-
-		if !hasMore {
-			break
+	allComments := first.comments
+	current := first
+	for page := 2; current.hasMore; page++ {
+		current, err = ip.fetchCommentsPage(ctx, since, page)
+		if err != nil {
+			return nil, err
 		}
-		page++
+		allComments = append(allComments, current.comments...)
 	}
 
 	return allComments, nil
 }
 
+// fetchPagesConcurrently fetches pages from through to with at most
+// concurrency requests in flight and returns their comments in page order.
+// The first failure cancels the requests still in flight.
+func (ip *IssueParser) fetchPagesConcurrently(ctx context.Context, since time.Time, from, to int) ([]GitHubComment, error) {
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]GitHubComment, to-from+1)
+	errs := make([]error, len(pages))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, ip.concurrency)
+	for i := range pages {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			page, err := ip.fetchCommentsPage(ctx, since, from+i)
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			pages[i] = page.comments
+		}()
+	}
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+	}
+
+	var comments []GitHubComment
+	for _, page := range pages {
+		comments = append(comments, page...)
+	}
+	return comments, nil
+}
+
+// commentsPage is a single page of comments.
+type commentsPage struct {
+	comments []GitHubComment
+	// hasMore reports whether a following page exists.
+	hasMore bool
+	// lastPage is the last page number from the Link header; zero if unknown.
+	lastPage int
+}
+
 // fetchCommentsPage retrieves a single page of comments.
-func (ip *IssueParser) fetchCommentsPage(ctx context.Context, since time.Time, page int) ([]GitHubComment, bool, error) {
-	url := fmt.Sprintf("%s/repos/golang/go/issues/%d/comments?per_page=%d&page=%d&since=%s",
-		ip.baseURL, ProposalReviewIssueNumber, perPage, page, since.Format(time.RFC3339))
+func (ip *IssueParser) fetchCommentsPage(ctx context.Context, since time.Time, page int) (*commentsPage, error) {
+	reqURL := ip.commentsURL(fmt.Sprintf("per_page=%d&page=%d&since=%s", ip.perPage, page, since.Format(time.RFC3339)))
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/vnd.github+json")
@@ -423,21 +765,22 @@ func (ip *IssueParser) fetchCommentsPage(ctx context.Context, since time.Time, p
 		req.Header.Set("If-None-Match", ip.etag)
 	}
 
-	resp, err := ip.httpClient.Do(req)
+	resp, err := ip.doRequest(req)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed to execute request: %w", err)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	// Handle 304 Not Modified (cached response)
+	// Handle 304 Not Modified: nothing changed since the stored ETag
 	if resp.StatusCode == http.StatusNotModified {
-		return []GitHubComment{}, false, nil
+		ip.logger.Info("comments not modified since last run", "etag", ip.etag)
+		return &commentsPage{comments: []GitHubComment{}}, nil
 	}
 
 	// Handle error responses
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, false, fmt.Errorf("GitHub API error: status=%d body=%s", resp.StatusCode, string(body))
+		return nil, fmt.Errorf("GitHub API error: status=%d body=%s", resp.StatusCode, string(body))
 	}
 
 	// Store ETag for future requests
@@ -446,15 +789,72 @@ func (ip *IssueParser) fetchCommentsPage(ctx context.Context, since time.Time, p
 	}
 
 	// Parse response
-	var comments []GitHubComment
-	if err := json.NewDecoder(resp.Body).Decode(&comments); err != nil {
-		return nil, false, fmt.Errorf("failed to decode response: %w", err)
+	comments, err := ip.decodeComments(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// GitHub announces following pages with rel="next" in the Link header;
+	// a full page alone does not mean another page exists
+	links := parseLinkHeader(resp.Header.Get("Link"))
+	_, hasMore := links["next"]
+	lastPage := pageFromURL(links["last"])
+
+	return &commentsPage{comments: comments, hasMore: hasMore, lastPage: lastPage}, nil
+}
+
+// parseLinkHeader parses a GitHub Link header into a map from relation
+// (e.g. "next", "last") to URL.
+func parseLinkHeader(header string) map[string]string {
+	links := make(map[string]string)
+	for link := range strings.SplitSeq(header, ",") {
+		target, params, ok := strings.Cut(link, ";")
+		if !ok {
+			continue
+		}
+		target = strings.TrimSpace(target)
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for param := range strings.SplitSeq(params, ";") {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || name != "rel" {
+				continue
+			}
+			for rel := range strings.FieldsSeq(strings.Trim(value, `"`)) {
+				links[rel] = target[1 : len(target)-1]
+			}
+		}
+	}
+	return links
+}
+
+// pageFromURL returns the page query parameter of a pagination URL, or zero
+// if it is missing or invalid.
+func pageFromURL(rawURL string) int {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0
+	}
+	page, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil || page < 1 {
+		return 0
 	}
+	return page
+}
 
-	// Check if there are more pages
-	hasMore := len(comments) == perPage
+// PagesFetched returns the number of comment pages received from the GitHub
+// API so far, including pages fetched only to find the previous comment.
+// Responses answered from the ETag cache are not counted.
+func (ip *IssueParser) PagesFetched() int {
+	return int(ip.pagesFetched.Load())
+}
 
-	return comments, hasMore, nil
+// LastCommentID returns the ID of the newest comment processed by the last
+// FetchChanges call, or the saved cursor if no new comment was processed.
+// Unlike the state file, it reflects the processed comments under DryRun.
+func (ip *IssueParser) LastCommentID() string {
+	return ip.lastCommentID
 }
 
 // WriteChangesJSON writes the changes to a JSON file.
@@ -496,3 +896,29 @@ func (ip *IssueParser) WriteChangesJSON(changes []ProposalChange, path string) e
 
 	return nil
 }
+
+// MergeChanges merges the changes fetched from several tracking issues.
+// When the same proposal appears more than once, the change with the latest
+// ChangedAt is kept. The result is sorted by ChangedAt, then IssueNumber.
+func MergeChanges(sources ...[]ProposalChange) []ProposalChange {
+	latest := make(map[int]ProposalChange)
+	for _, changes := range sources {
+		for _, c := range changes {
+			if existing, ok := latest[c.IssueNumber]; !ok || c.ChangedAt.After(existing.ChangedAt) {
+				latest[c.IssueNumber] = c
+			}
+		}
+	}
+
+	merged := make([]ProposalChange, 0, len(latest))
+	for _, c := range latest {
+		merged = append(merged, c)
+	}
+	slices.SortFunc(merged, func(a, b ProposalChange) int {
+		if c := a.ChangedAt.Compare(b.ChangedAt); c != 0 {
+			return c
+		}
+		return a.IssueNumber - b.IssueNumber
+	})
+	return merged
+}
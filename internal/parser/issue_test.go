@@ -1,14 +1,21 @@
 package parser_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -103,6 +110,56 @@ func TestNewIssueParser(t *testing.T) {
 			},
 			wantErr: parser.ErrNilStateManager,
 		},
+		{
+			name: "正常系: 独自のトラッキングIssue",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				Owner:        "example",
+				Repo:         "proposals",
+				IssueNumber:  42,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: Owner指定でIssueNumberなし",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				Owner:        "example",
+			},
+			wantErr: parser.ErrInvalidIssueNumber,
+		},
+		{
+			name: "異常系: Repo指定でIssueNumberなし",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				Repo:         "proposals",
+			},
+			wantErr: parser.ErrInvalidIssueNumber,
+		},
+		{
+			name: "異常系: 負のIssueNumber",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				IssueNumber:  -1,
+			},
+			wantErr: parser.ErrInvalidIssueNumber,
+		},
+		{
+			name: "正常系: パス付きのBaseURL",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				BaseURL:      "https://git.example.com/api/v3",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "異常系: スキームのないBaseURL",
+			config: parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				BaseURL:      "git.example.com/api/v3",
+			},
+			wantErr: parser.ErrInvalidBaseURL,
+		},
 	}
 
 	for _, tt := range tests {
@@ -439,6 +496,202 @@ func TestIssueParser_FetchChanges_ETagCaching(t *testing.T) {
 	}
 }
 
+func TestIssueParser_FetchChanges_PersistedETag(t *testing.T) {
+	t.Parallel()
+
+	const serverETag = `"v2"`
+	lastProcessedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	comment := map[string]any{
+		"id":         int64(12001),
+		"body":       "**2026-02-06** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+		"created_at": lastProcessedAt.Add(time.Hour).Format(time.RFC3339),
+		"updated_at": lastProcessedAt.Add(time.Hour).Format(time.RFC3339),
+		"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-12001",
+	}
+
+	tests := []struct {
+		name            string
+		storedETag      string
+		wantIfNoneMatch string
+		wantETag        string
+		wantChanges     int
+	}{
+		{
+			name:            "正常系: 保存済みETagが一致すると304で変更なし",
+			storedETag:      serverETag,
+			wantIfNoneMatch: serverETag,
+			wantETag:        serverETag,
+			wantChanges:     0,
+		},
+		{
+			name:            "正常系: 古いETagは200で更新される",
+			storedETag:      `"v1"`,
+			wantIfNoneMatch: `"v1"`,
+			wantETag:        serverETag,
+			wantChanges:     1,
+		},
+		{
+			name:        "正常系: 不正なETagは送信されず上書きされる",
+			storedETag:  "not-quoted",
+			wantETag:    serverETag,
+			wantChanges: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotIfNoneMatch atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("page") == "1" {
+					gotIfNoneMatch.Store(r.Header.Get("If-None-Match"))
+					if r.Header.Get("If-None-Match") == serverETag {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+					w.Header().Set("ETag", serverETag)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]map[string]any{comment})
+			}))
+			t.Cleanup(server.Close)
+
+			sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+			if err := sm.SaveState(&parser.State{
+				LastCommentID:   "12000",
+				LastProcessedAt: lastProcessedAt,
+				ETag:            tt.storedETag,
+			}); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+
+			// A new parser simulates a separate run reading the persisted ETag
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: sm,
+				BaseURL:      server.URL,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != tt.wantChanges {
+				t.Errorf("expected %d changes, got %d", tt.wantChanges, len(changes))
+			}
+			if got, _ := gotIfNoneMatch.Load().(string); got != tt.wantIfNoneMatch {
+				t.Errorf("If-None-Match = %q, want %q", got, tt.wantIfNoneMatch)
+			}
+
+			state, err := sm.LoadState()
+			if err != nil {
+				t.Fatalf("failed to load state: %v", err)
+			}
+			if state.ETag != tt.wantETag {
+				t.Errorf("state ETag = %q, want %q", state.ETag, tt.wantETag)
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_CommentFieldMapping(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	body := "**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n"
+
+	tests := []struct {
+		config  parser.IssueParserConfig
+		comment map[string]any
+		name    string
+		wantURL string
+	}{
+		{
+			name: "Gitea形式: html_urlなしでURLを合成",
+			config: parser.IssueParserConfig{
+				CommentURLTemplate: "https://gitea.example.com/golang/go/issues/{issue}#issuecomment-{id}",
+			},
+			comment: map[string]any{
+				"id":         int64(5001),
+				"body":       body,
+				"created_at": now.Format(time.RFC3339),
+				"updated_at": now.Format(time.RFC3339),
+			},
+			wantURL: "https://gitea.example.com/golang/go/issues/33502#issuecomment-5001",
+		},
+		{
+			name: "html_urlがある場合はテンプレートより優先",
+			config: parser.IssueParserConfig{
+				CommentURLTemplate: "https://gitea.example.com/golang/go/issues/{issue}#issuecomment-{id}",
+			},
+			comment: map[string]any{
+				"id":         int64(5002),
+				"body":       body,
+				"created_at": now.Format(time.RFC3339),
+				"html_url":   "https://forge.example.com/comments/5002",
+			},
+			wantURL: "https://forge.example.com/comments/5002",
+		},
+		{
+			name: "フィールド名のマッピング",
+			config: parser.IssueParserConfig{
+				CommentFields: parser.CommentFieldMapping{
+					ID:        "comment_id",
+					Body:      "content",
+					CreatedAt: "created",
+					HTMLURL:   "url",
+				},
+			},
+			comment: map[string]any{
+				"comment_id": "5003",
+				"content":    body,
+				"created":    now.Format(time.RFC3339),
+				"updated_at": nil,
+				"url":        "https://forge.example.com/comments/5003",
+			},
+			wantURL: "https://forge.example.com/comments/5003",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]map[string]any{tt.comment})
+			}))
+			defer server.Close()
+
+			config := tt.config
+			config.StateManager = parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+			config.BaseURL = server.URL
+
+			ip, err := parser.NewIssueParser(config)
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != 1 {
+				t.Fatalf("expected 1 change, got %d", len(changes))
+			}
+			if changes[0].CommentURL != tt.wantURL {
+				t.Errorf("CommentURL = %q, want %q", changes[0].CommentURL, tt.wantURL)
+			}
+			if changes[0].IssueNumber != 11111 {
+				t.Errorf("IssueNumber = %d, want 11111", changes[0].IssueNumber)
+			}
+		})
+	}
+}
+
 func TestIssueParser_WriteChangesJSON(t *testing.T) {
 	t.Parallel()
 
@@ -594,6 +847,79 @@ func TestIssueParser_WriteChangesJSON(t *testing.T) {
 	}
 }
 
+func TestIssueParser_IncludeRaw(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	body := "**2026-01-30** / **@rsc**\n\n- #12345 **proposal: raw body**\n  - **accepted**\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]any{{
+			"id":         int64(1001),
+			"body":       body,
+			"created_at": now.Format(time.RFC3339),
+			"updated_at": now.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-1001",
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	tests := []struct {
+		name       string
+		includeRaw bool
+	}{
+		{name: "raw comment included", includeRaw: true},
+		{name: "raw comment omitted by default", includeRaw: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			sm := parser.NewStateManager(filepath.Join(dir, "state.json"))
+			if err := sm.SaveState(&parser.State{LastCommentID: "999", LastProcessedAt: now.Add(-time.Hour)}); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: sm,
+				BaseURL:      server.URL,
+				IncludeRaw:   tt.includeRaw,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != 1 {
+				t.Fatalf("changes = %+v, want one change", changes)
+			}
+			wantRaw := ""
+			if tt.includeRaw {
+				wantRaw = body
+			}
+			if changes[0].RawComment != wantRaw {
+				t.Errorf("RawComment = %q, want %q", changes[0].RawComment, wantRaw)
+			}
+
+			outputPath := filepath.Join(dir, "changes.json")
+			if err := ip.WriteChangesJSON(changes, outputPath); err != nil {
+				t.Fatalf("WriteChangesJSON failed: %v", err)
+			}
+			data, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("failed to read output: %v", err)
+			}
+			if got := strings.Contains(string(data), `"raw_comment"`); got != tt.includeRaw {
+				t.Errorf("raw_comment in changes.json = %v, want %v", got, tt.includeRaw)
+			}
+		})
+	}
+}
+
 func TestIssueParser_WriteChangesJSON_WriteError(t *testing.T) {
 	t.Parallel()
 
@@ -670,6 +996,7 @@ func TestIssueParser_FetchChanges_Pagination(t *testing.T) {
 			// First page request or fetchPreviousComment request (no page param for fetchPreviousComment)
 			if pageParam == "1" {
 				paginationRequests++
+				w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next", <http://%s%s?page=2>; rel="last"`, r.Host, r.URL.Path, r.Host, r.URL.Path))
 			}
 			for _, c := range page1Comments {
 				comments = append(comments, map[string]any{
@@ -740,68 +1067,999 @@ func TestIssueParser_FetchChanges_Pagination(t *testing.T) {
 	}
 }
 
-func TestIssueParser_FetchChanges_ContextCancellation(t *testing.T) {
+func TestIssueParser_FetchChanges_SecondaryRateLimit(t *testing.T) {
 	t.Parallel()
 
-	// Server that delays response
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		select {
-		case <-r.Context().Done():
-			return
-		case <-time.After(5 * time.Second):
-			w.Header().Set("Content-Type", "application/json")
-			_ = json.NewEncoder(w).Encode([]map[string]any{})
-		}
-	}))
-	defer server.Close()
-
-	tmpDir := t.TempDir()
-	statePath := filepath.Join(tmpDir, "state.json")
-	sm := parser.NewStateManager(statePath)
-
-	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
-		StateManager: sm,
-		BaseURL:      server.URL,
-		Token:        "test-token",
-	})
-	if err != nil {
-		t.Fatalf("failed to create IssueParser: %v", err)
+	now := time.Now().Truncate(time.Second)
+	comment := map[string]any{
+		"id":         int64(6001),
+		"body":       "**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+		"created_at": now.Format(time.RFC3339),
+		"updated_at": now.Format(time.RFC3339),
+		"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-6001",
 	}
 
-	// Create a context that will be cancelled quickly
-	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
-	defer cancel()
-
-	_, err = ip.FetchChanges(ctx)
-	if err == nil {
-		t.Error("expected error due to context cancellation, got nil")
+	tests := []struct {
+		header  http.Header
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "403 with Retry-After is retried",
+			header: http.Header{"Retry-After": []string{"0"}},
+			body:   `{"message":"You have exceeded a secondary rate limit."}`,
+		},
+		{
+			name:    "permission 403 fails immediately",
+			body:    `{"message":"Resource not accessible by integration"}`,
+			wantErr: true,
+		},
 	}
-}
 
-func TestIssueParser_FetchChanges_StateLoadError(t *testing.T) {
-	t.Parallel()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	tmpDir := t.TempDir()
-	statePath := filepath.Join(tmpDir, "state.json")
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					for k, v := range tt.header {
+						w.Header()[k] = v
+					}
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(tt.body))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]map[string]any{comment})
+			}))
+			defer server.Close()
 
-	// Write invalid JSON to state file
-	if err := os.WriteFile(statePath, []byte("invalid json{"), 0644); err != nil {
-		t.Fatalf("failed to write invalid state file: %v", err)
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				BaseURL:      server.URL,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if tt.wantErr {
+				if got := requests.Load(); got != 1 {
+					t.Errorf("requests = %d, want 1 (no retry)", got)
+				}
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), "status=403") {
+					t.Errorf("error = %v, want status=403", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if got := requests.Load(); got < 2 {
+				t.Errorf("requests = %d, want the rate-limited request to be retried", got)
+			}
+			if len(changes) != 1 {
+				t.Errorf("expected 1 change, got %d", len(changes))
+			}
+		})
 	}
+}
 
-	sm := parser.NewStateManager(statePath)
+func TestIssueParser_FetchChanges_TransientErrorRetry(t *testing.T) {
+	t.Parallel()
 
-	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
-		StateManager: sm,
-		BaseURL:      "https://api.github.com",
-		Token:        "test-token",
-	})
-	if err != nil {
-		t.Fatalf("failed to create IssueParser: %v", err)
+	now := time.Now().Truncate(time.Second)
+	comment := map[string]any{
+		"id":         int64(6101),
+		"body":       "**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+		"created_at": now.Format(time.RFC3339),
+		"updated_at": now.Format(time.RFC3339),
+		"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-6101",
 	}
 
-	_, err = ip.FetchChanges(context.Background())
-	if err == nil {
+	tests := []struct {
+		header       http.Header
+		name         string
+		status       int
+		failures     int32
+		maxRetries   int
+		wantRequests int32
+		wantErr      bool
+	}{
+		// A successful run takes two requests: the latest comment and
+		// the lookup of the comment preceding it.
+		{
+			name:         "正常系: 503は指数バックオフで再試行される",
+			status:       http.StatusServiceUnavailable,
+			failures:     2,
+			maxRetries:   3,
+			wantRequests: 4,
+		},
+		{
+			name:         "正常系: 500は再試行される",
+			status:       http.StatusInternalServerError,
+			failures:     1,
+			maxRetries:   3,
+			wantRequests: 3,
+		},
+		{
+			name:         "正常系: 429はRetry-Afterに従って再試行される",
+			header:       http.Header{"Retry-After": []string{"0"}},
+			status:       http.StatusTooManyRequests,
+			failures:     1,
+			maxRetries:   1,
+			wantRequests: 3,
+		},
+		{
+			name:         "異常系: 再試行回数を超えると失敗する",
+			status:       http.StatusServiceUnavailable,
+			failures:     5,
+			maxRetries:   2,
+			wantRequests: 3,
+			wantErr:      true,
+		},
+		{
+			name:         "異常系: 再試行なしの場合は即座に失敗する",
+			status:       http.StatusServiceUnavailable,
+			failures:     1,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "異常系: 404は再試行されない",
+			status:       http.StatusNotFound,
+			failures:     1,
+			maxRetries:   3,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) <= tt.failures {
+					for k, v := range tt.header {
+						w.Header()[k] = v
+					}
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]map[string]any{comment})
+			}))
+			t.Cleanup(server.Close)
+
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager:   parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				BaseURL:        server.URL,
+				MaxRetries:     tt.maxRetries,
+				RetryBaseDelay: time.Millisecond,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != 1 {
+				t.Errorf("expected 1 change, got %d", len(changes))
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_RetryContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager:   parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+		BaseURL:        server.URL,
+		MaxRetries:     3,
+		RetryBaseDelay: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = ip.FetchChanges(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("FetchChanges took %v, want prompt abort on cancellation", elapsed)
+	}
+}
+
+func TestIssueParser_FetchChanges_FullPageWithoutNextLink(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	comments := make([]map[string]any, 100) // exactly perPage
+	for i := range comments {
+		body := "Regular comment"
+		if i == len(comments)-1 {
+			body = "**2026-01-30** / **@rsc**\n\n- #12345 **proposal: last page**\n  - **accepted**\n"
+		}
+		comments[i] = map[string]any{
+			"id":         int64(5000 + i),
+			"body":       body,
+			"created_at": now.Format(time.RFC3339),
+			"updated_at": now.Format(time.RFC3339),
+			"html_url":   fmt.Sprintf("https://github.com/golang/go/issues/33502#issuecomment-%d", 5000+i),
+		}
+	}
+
+	var pageRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "" {
+			pageRequests.Add(1)
+			// A Link header without rel="next" marks the last page
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=1>; rel="first"`, r.Host, r.URL.Path))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(comments)
+	}))
+	t.Cleanup(server.Close)
+
+	sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+	if err := sm.SaveState(&parser.State{LastCommentID: "999", LastProcessedAt: now.Add(-time.Hour)}); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager: sm,
+		BaseURL:      server.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	changes, err := ip.FetchChanges(context.Background())
+	if err != nil {
+		t.Fatalf("FetchChanges failed: %v", err)
+	}
+	if got := pageRequests.Load(); got != 1 {
+		t.Errorf("page requests = %d, want 1 (no request past the last page)", got)
+	}
+	if len(changes) != 1 || changes[0].IssueNumber != 12345 {
+		t.Errorf("changes = %+v, want one change for #12345", changes)
+	}
+}
+
+func TestIssueParser_FetchChanges_PerPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		perPage int
+		want    string
+	}{
+		{name: "default", perPage: 0, want: "100"},
+		{name: "configured", perPage: 50, want: "50"},
+		{name: "clamped to the GitHub maximum", perPage: 500, want: "100"},
+		{name: "negative uses default", perPage: -1, want: "100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now().Truncate(time.Second)
+			var (
+				mu       sync.Mutex
+				perPages []string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				page := r.URL.Query().Get("page")
+				var comments []map[string]any
+				if page != "" {
+					mu.Lock()
+					perPages = append(perPages, r.URL.Query().Get("per_page"))
+					mu.Unlock()
+					if page == "1" {
+						w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next", <http://%s%s?page=2>; rel="last"`,
+							r.Host, r.URL.Path, r.Host, r.URL.Path))
+					}
+					n, _ := strconv.Atoi(page)
+					comments = append(comments, map[string]any{
+						"id":         int64(1000 + n),
+						"body":       "Regular comment",
+						"created_at": now.Format(time.RFC3339),
+						"updated_at": now.Format(time.RFC3339),
+						"html_url":   fmt.Sprintf("https://github.com/golang/go/issues/33502#issuecomment-%d", 1000+n),
+					})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(comments)
+			}))
+			t.Cleanup(server.Close)
+
+			sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+			if err := sm.SaveState(&parser.State{LastCommentID: "999", LastProcessedAt: now.Add(-time.Hour)}); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: sm,
+				BaseURL:      server.URL,
+				PerPage:      tt.perPage,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			if _, err := ip.FetchChanges(context.Background()); err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(perPages) != 2 {
+				t.Fatalf("page requests = %d, want 2 following the Link header", len(perPages))
+			}
+			for i, got := range perPages {
+				if got != tt.want {
+					t.Errorf("page %d per_page = %q, want %q", i+1, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_DeferStateSave(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode([]map[string]any{{
+			"id":         int64(5000),
+			"body":       "**2026-01-30** / **@rsc**\n\n- #12345 **proposal: deferred**\n  - **accepted**\n",
+			"created_at": now.Format(time.RFC3339),
+			"updated_at": now.Format(time.RFC3339),
+			"html_url":   "https://github.com/golang/go/issues/33502#issuecomment-5000",
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+	if err := sm.SaveState(&parser.State{LastCommentID: "999", LastProcessedAt: now.Add(-time.Hour)}); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager:   sm,
+		BaseURL:        server.URL,
+		DeferStateSave: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	if _, err := ip.FetchChanges(context.Background()); err != nil {
+		t.Fatalf("FetchChanges failed: %v", err)
+	}
+	state, err := sm.LoadState()
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if state.LastCommentID != "999" {
+		t.Errorf("LastCommentID after FetchChanges = %q, want %q (unchanged)", state.LastCommentID, "999")
+	}
+
+	if err := ip.SaveState(); err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+	state, err = sm.LoadState()
+	if err != nil {
+		t.Fatalf("failed to load state: %v", err)
+	}
+	if state.LastCommentID != "5000" {
+		t.Errorf("LastCommentID after SaveState = %q, want %q", state.LastCommentID, "5000")
+	}
+}
+
+func TestIssueParser_FetchChanges_ConcurrentPagination(t *testing.T) {
+	t.Parallel()
+
+	const lastPage = 5
+	lastProcessedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		concurrency     int
+		wantMaxInFlight int32
+	}{
+		{name: "正常系: 並列数0では逐次取得", concurrency: 0, wantMaxInFlight: 1},
+		{name: "正常系: 並列数3で残りのページを並列取得", concurrency: 3, wantMaxInFlight: 3},
+		{name: "正常系: 並列数が残りページ数を超える", concurrency: 10, wantMaxInFlight: lastPage - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var inFlight, maxInFlight, requests atomic.Int32
+			// reached is closed once the expected number of page requests
+			// overlap, so that the handlers wait for each other instead of
+			// relying on timing.
+			reached := make(chan struct{})
+			var once sync.Once
+
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				if page == 0 {
+					// fetchPreviousComment
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte("[]"))
+					return
+				}
+				requests.Add(1)
+
+				if page > 1 {
+					n := inFlight.Add(1)
+					defer inFlight.Add(-1)
+					for {
+						current := maxInFlight.Load()
+						if n <= current || maxInFlight.CompareAndSwap(current, n) {
+							break
+						}
+					}
+					if n >= tt.wantMaxInFlight {
+						once.Do(func() { close(reached) })
+					}
+					select {
+					case <-reached:
+					case <-time.After(2 * time.Second):
+					}
+				}
+
+				link := fmt.Sprintf(`<%s/?page=%d>; rel="last"`, server.URL, lastPage)
+				if page < lastPage {
+					link = fmt.Sprintf(`<%s/?page=%d>; rel="next", `, server.URL, page+1) + link
+				}
+				w.Header().Set("Link", link)
+				w.Header().Set("Content-Type", "application/json")
+				at := lastProcessedAt.Add(time.Duration(page) * time.Hour)
+				_ = json.NewEncoder(w).Encode([]map[string]any{{
+					"id":         int64(20000 + page),
+					"body":       fmt.Sprintf("**2026-01-07** / **@rsc**\n\n- #%d **proposal %d**\n  - **accepted**\n", 30000+page, page),
+					"created_at": at.Format(time.RFC3339),
+					"updated_at": at.Format(time.RFC3339),
+					"html_url":   fmt.Sprintf("https://github.com/golang/go/issues/33502#issuecomment-%d", 20000+page),
+				}})
+			}))
+			t.Cleanup(server.Close)
+
+			sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+			if err := sm.SaveState(&parser.State{LastCommentID: "1", LastProcessedAt: lastProcessedAt}); err != nil {
+				t.Fatalf("failed to save state: %v", err)
+			}
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: sm,
+				BaseURL:      server.URL,
+				Concurrency:  tt.concurrency,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+
+			if got := requests.Load(); got != lastPage {
+				t.Errorf("page requests = %d, want %d", got, lastPage)
+			}
+			if got := maxInFlight.Load(); got != tt.wantMaxInFlight {
+				t.Errorf("max page requests in flight = %d, want %d", got, tt.wantMaxInFlight)
+			}
+
+			// Changes keep the chronological order of the pages
+			if len(changes) != lastPage {
+				t.Fatalf("expected %d changes, got %d", lastPage, len(changes))
+			}
+			for i, c := range changes {
+				if want := 30001 + i; c.IssueNumber != want {
+					t.Errorf("changes[%d].IssueNumber = %d, want %d", i, c.IssueNumber, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_ConcurrentPaginationCancel(t *testing.T) {
+	t.Parallel()
+
+	var canceled atomic.Int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			// Block until the client gives up on the request
+			<-r.Context().Done()
+			canceled.Add(1)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/?page=4>; rel="last"`, server.URL))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	t.Cleanup(server.Close)
+
+	sm := parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+	if err := sm.SaveState(&parser.State{LastCommentID: "1", LastProcessedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}); err != nil {
+		t.Fatalf("failed to save state: %v", err)
+	}
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager: sm,
+		BaseURL:      server.URL,
+		Concurrency:  3,
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, err := ip.FetchChanges(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want context.DeadlineExceeded", err)
+	}
+
+	// Every in-flight page request observes the cancellation
+	deadline := time.Now().Add(2 * time.Second)
+	for canceled.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := canceled.Load(); got != 3 {
+		t.Errorf("canceled page requests = %d, want 3", got)
+	}
+}
+
+func TestIssueParser_FetchChanges_OversizedComment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+	body := "**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n" +
+		strings.Repeat("padding line\n", 100)
+
+	tests := []struct {
+		name        string
+		wantLog     string
+		wantChanges int
+		truncate    bool
+	}{
+		{
+			name:        "oversized comment is skipped",
+			truncate:    false,
+			wantChanges: 0,
+			wantLog:     "skipping oversized comment",
+		},
+		{
+			name:        "oversized comment is truncated",
+			truncate:    true,
+			wantChanges: 1,
+			wantLog:     "truncating oversized comment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := setupMockServer(t, serverConfig{
+				comments: []mockComment{
+					{
+						ID:        7001,
+						Body:      body,
+						CreatedAt: now,
+						HTMLURL:   "https://github.com/golang/go/issues/33502#issuecomment-7001",
+					},
+				},
+			})
+			defer server.Close()
+
+			var logs bytes.Buffer
+			statePath := filepath.Join(t.TempDir(), "state.json")
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager:              parser.NewStateManager(statePath),
+				Logger:                    slog.New(slog.NewTextHandler(&logs, nil)),
+				BaseURL:                   server.URL,
+				MaxCommentBodySize:        200,
+				TruncateOversizedComments: tt.truncate,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != tt.wantChanges {
+				t.Errorf("expected %d changes, got %d", tt.wantChanges, len(changes))
+			}
+			if !strings.Contains(logs.String(), tt.wantLog) {
+				t.Errorf("logs should contain %q, got:\n%s", tt.wantLog, logs.String())
+			}
+
+			// The oversized comment still advances the state cursor.
+			state, err := parser.NewStateManager(statePath).LoadState()
+			if err != nil {
+				t.Fatalf("failed to load state: %v", err)
+			}
+			if state.LastCommentID != "7001" {
+				t.Errorf("LastCommentID = %q, want %q", state.LastCommentID, "7001")
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_NonMinutesComment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name        string
+		body        string
+		wantChanges int
+	}{
+		{
+			name:        "decoy comment with lookalike bullet",
+			body:        "I think this is related:\n\n- #11111 **test proposal**\n  - **accepted**\n",
+			wantChanges: 0,
+		},
+		{
+			name:        "lookalike bullet before a header",
+			body:        "- #11111 **test proposal**\n  - **accepted**\n\n**2026-01-30** / **@rsc**\n",
+			wantChanges: 0,
+		},
+		{
+			name:        "header without a reviewer",
+			body:        "**2026-01-30**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+			wantChanges: 0,
+		},
+		{
+			name:        "minutes with a preceding note",
+			body:        "[Posting a day late.]\n\n**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+			wantChanges: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := setupMockServer(t, serverConfig{
+				comments: []mockComment{
+					{
+						ID:        9001,
+						Body:      tt.body,
+						CreatedAt: now,
+						HTMLURL:   "https://github.com/golang/go/issues/33502#issuecomment-9001",
+					},
+				},
+			})
+			defer server.Close()
+
+			ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+				StateManager: parser.NewStateManager(filepath.Join(t.TempDir(), "state.json")),
+				Logger:       slog.New(slog.NewTextHandler(io.Discard, nil)),
+				BaseURL:      server.URL,
+			})
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+			if len(changes) != tt.wantChanges {
+				t.Errorf("expected %d changes, got %d: %+v", tt.wantChanges, len(changes), changes)
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_RequestDelay(t *testing.T) {
+	t.Parallel()
+
+	const delay = 50 * time.Millisecond
+	now := time.Now().Truncate(time.Second)
+
+	var requestCount atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount.Add(1)
+
+		// Announce a second page on the first page so that it is requested
+		var comments []map[string]any
+		if r.URL.Query().Get("page") == "1" {
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next"`, r.Host, r.URL.Path))
+			for i := range 100 {
+				comments = append(comments, map[string]any{
+					"id":         int64(1000 + i),
+					"body":       "no proposals here",
+					"created_at": now.Format(time.RFC3339),
+					"updated_at": now.Format(time.RFC3339),
+				})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(comments)
+	}))
+	defer server.Close()
+
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	stateContent := fmt.Sprintf(`{"lastProcessedAt":"%s","lastCommentId":"999"}`, now.Add(-time.Hour).Format(time.RFC3339))
+	if err := os.WriteFile(statePath, []byte(stateContent), 0644); err != nil {
+		t.Fatalf("failed to write state file: %v", err)
+	}
+
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager:          parser.NewStateManager(statePath),
+		BaseURL:               server.URL,
+		MaxConcurrentRequests: 1,
+		RequestDelay:          delay,
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := ip.FetchChanges(context.Background()); err != nil {
+		t.Fatalf("FetchChanges failed: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	// Two pages plus the baseline (previous comment) request
+	requests := int(requestCount.Load())
+	if requests < 3 {
+		t.Fatalf("expected at least 3 requests, got %d", requests)
+	}
+	if want := time.Duration(requests-1) * delay; elapsed < want {
+		t.Errorf("elapsed = %v, want at least %v for %d requests", elapsed, want, requests)
+	}
+}
+
+func TestIssueParser_FetchChanges_TrackingIssue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name      string
+		config    parser.IssueParserConfig
+		basePath  string
+		wantPath  string
+		wantIssue string
+	}{
+		{
+			name:      "defaults to golang/go#33502",
+			wantPath:  "/repos/golang/go/issues/33502/comments",
+			wantIssue: "33502",
+		},
+		{
+			name: "custom repository and issue",
+			config: parser.IssueParserConfig{
+				Owner:       "example",
+				Repo:        "proposals",
+				IssueNumber: 42,
+			},
+			wantPath:  "/repos/example/proposals/issues/42/comments",
+			wantIssue: "42",
+		},
+		{
+			name:      "GitHub Enterprise path prefix",
+			basePath:  "/api/v3",
+			wantPath:  "/api/v3/repos/golang/go/issues/33502/comments",
+			wantIssue: "33502",
+		},
+		{
+			name:      "path prefix with duplicate slashes",
+			basePath:  "//api//v3//",
+			wantPath:  "/api/v3/repos/golang/go/issues/33502/comments",
+			wantIssue: "33502",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var paths sync.Map
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				paths.Store(r.URL.Path, true)
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode([]map[string]any{{
+					"id":         int64(8001),
+					"body":       "**2026-01-30** / **@rsc**\n\n- #11111 **test proposal**\n  - **accepted**\n",
+					"created_at": now.Format(time.RFC3339),
+					"updated_at": now.Format(time.RFC3339),
+				}})
+			}))
+			defer server.Close()
+
+			config := tt.config
+			config.StateManager = parser.NewStateManager(filepath.Join(t.TempDir(), "state.json"))
+			// A trailing slash on the base URL must not produce "//repos".
+			config.BaseURL = server.URL + tt.basePath + "/"
+			config.CommentURLTemplate = "https://example.com/issues/{issue}#issuecomment-{id}"
+
+			ip, err := parser.NewIssueParser(config)
+			if err != nil {
+				t.Fatalf("failed to create IssueParser: %v", err)
+			}
+
+			changes, err := ip.FetchChanges(context.Background())
+			if err != nil {
+				t.Fatalf("FetchChanges failed: %v", err)
+			}
+
+			paths.Range(func(key, _ any) bool {
+				if key != tt.wantPath {
+					t.Errorf("request path = %q, want %q", key, tt.wantPath)
+				}
+				return true
+			})
+			if len(changes) != 1 {
+				t.Fatalf("expected 1 change, got %d", len(changes))
+			}
+
+			if want := "https://example.com/issues/" + tt.wantIssue + "#issuecomment-8001"; changes[0].CommentURL != want {
+				t.Errorf("CommentURL = %q, want %q", changes[0].CommentURL, want)
+			}
+		})
+	}
+}
+
+func TestIssueParser_FetchChanges_ContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	// Server that delays response
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(5 * time.Second):
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode([]map[string]any{})
+		}
+	}))
+	defer server.Close()
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+	sm := parser.NewStateManager(statePath)
+
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager: sm,
+		BaseURL:      server.URL,
+		Token:        "test-token",
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	// Create a context that will be cancelled quickly
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = ip.FetchChanges(ctx)
+	if err == nil {
+		t.Error("expected error due to context cancellation, got nil")
+	}
+}
+
+func TestIssueParser_FetchChanges_StateLoadError(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, "state.json")
+
+	// Write invalid JSON to state file
+	if err := os.WriteFile(statePath, []byte("invalid json{"), 0644); err != nil {
+		t.Fatalf("failed to write invalid state file: %v", err)
+	}
+
+	sm := parser.NewStateManager(statePath)
+
+	ip, err := parser.NewIssueParser(parser.IssueParserConfig{
+		StateManager: sm,
+		BaseURL:      "https://api.github.com",
+		Token:        "test-token",
+	})
+	if err != nil {
+		t.Fatalf("failed to create IssueParser: %v", err)
+	}
+
+	_, err = ip.FetchChanges(context.Background())
+	if err == nil {
 		t.Error("expected error due to invalid state file, got nil")
 	}
 }
+
+func TestMergeChanges(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	change := func(issue int, status parser.Status, offset time.Duration) parser.ProposalChange {
+		return parser.ProposalChange{IssueNumber: issue, CurrentStatus: status, ChangedAt: base.Add(offset)}
+	}
+
+	tests := []struct {
+		name    string
+		sources [][]parser.ProposalChange
+		want    []parser.ProposalChange
+	}{
+		{
+			name: "no sources",
+			want: []parser.ProposalChange{},
+		},
+		{
+			name: "duplicate keeps latest change",
+			sources: [][]parser.ProposalChange{
+				{change(1, parser.StatusLikelyAccept, time.Hour), change(2, parser.StatusActive, 0)},
+				{change(1, parser.StatusAccepted, 2*time.Hour), change(3, parser.StatusDeclined, time.Hour)},
+			},
+			want: []parser.ProposalChange{
+				change(2, parser.StatusActive, 0),
+				change(3, parser.StatusDeclined, time.Hour),
+				change(1, parser.StatusAccepted, 2*time.Hour),
+			},
+		},
+		{
+			name: "older duplicate in later source is dropped",
+			sources: [][]parser.ProposalChange{
+				{change(1, parser.StatusAccepted, 2*time.Hour)},
+				{change(1, parser.StatusLikelyAccept, time.Hour)},
+			},
+			want: []parser.ProposalChange{change(1, parser.StatusAccepted, 2*time.Hour)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := parser.MergeChanges(tt.sources...)
+			if len(got) != len(tt.want) {
+				t.Fatalf("MergeChanges() returned %d changes, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].IssueNumber != tt.want[i].IssueNumber || got[i].CurrentStatus != tt.want[i].CurrentStatus || !got[i].ChangedAt.Equal(tt.want[i].ChangedAt) {
+					t.Errorf("change %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
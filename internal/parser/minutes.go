@@ -3,6 +3,8 @@ package parser
 
 import (
 	"log/slog"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -25,8 +27,33 @@ const (
 	StatusDeclined      Status = "declined"
 	StatusHold          Status = "hold"
 	StatusActive        Status = "active"
+	StatusRetracted     Status = "retracted"
+	StatusRemoved       Status = "removed"
 )
 
+// IsKnown reports whether s is one of the defined statuses.
+func (s Status) IsKnown() bool {
+	switch s {
+	case StatusDiscussions, StatusLikelyAccept, StatusLikelyDecline,
+		StatusAccepted, StatusDeclined, StatusHold, StatusActive,
+		StatusRetracted, StatusRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTerminal reports whether s is a final outcome (accepted, declined,
+// retracted or removed) that is not expected to change in later meetings.
+func (s Status) IsTerminal() bool {
+	switch s {
+	case StatusAccepted, StatusDeclined, StatusRetracted, StatusRemoved:
+		return true
+	default:
+		return false
+	}
+}
+
 // ProposalChange represents a detected status change for a proposal.
 type ProposalChange struct {
 	ChangedAt      time.Time `json:"changed_at"`
@@ -34,8 +61,10 @@ type ProposalChange struct {
 	PreviousStatus Status    `json:"previous_status"`
 	CurrentStatus  Status    `json:"current_status"`
 	CommentURL     string    `json:"comment_url"`
+	Reviewer       string    `json:"reviewer,omitempty"` // GitHub login of the minutes recorder, without "@"; empty if unknown
 	RelatedIssues  []int     `json:"related_issues"`
 	IssueNumber    int       `json:"issue_number"`
+	RawComment     string    `json:"raw_comment,omitempty"` // Full minutes comment body; set only with IssueParserConfig.IncludeRaw
 }
 
 // sectionHeaderPatterns maps section header keywords to their status.
@@ -51,6 +80,8 @@ var sectionHeaderPatterns = []struct {
 	{"**likely decline**", StatusLikelyDecline},
 	{"**active**", StatusActive},
 	{"**hold**", StatusHold},
+	{"**retracted**", StatusRetracted},
+	{"**removed**", StatusRemoved},
 	{"**discussions**", StatusDiscussions},
 	{"**discussion**", StatusDiscussions},
 }
@@ -63,6 +94,18 @@ var statusPatterns = []struct {
 	status         Status
 	requiresEndPos bool // true if the last keyword must be at the end of the line
 }{
+	// Retracted patterns. A retraction recorded as "proposal retracted by
+	// author; **declined**" is a decision and stays declined.
+	{[]string{"retracted", "**declined**"}, StatusDeclined, false},
+	{[]string{"**retracted**"}, StatusRetracted, false},
+	{[]string{"retracted"}, StatusRetracted, true}, // Must be at end of line
+
+	// Removed patterns
+	{[]string{"removed from proposal process"}, StatusRemoved, false},
+	{[]string{"removed from process"}, StatusRemoved, false},
+	{[]string{"**removed**"}, StatusRemoved, false},
+	{[]string{"removed"}, StatusRemoved, true}, // Must be at end of line
+
 	// Accepted patterns
 	{[]string{"**no final comments; accepted"}, StatusAccepted, false},
 	{[]string{"; **accepted**"}, StatusAccepted, false},
@@ -74,7 +117,6 @@ var statusPatterns = []struct {
 	{[]string{"**no final comments; declined"}, StatusDeclined, false},
 	{[]string{"; **declined**"}, StatusDeclined, false},
 	{[]string{"**declined**"}, StatusDeclined, false},
-	{[]string{"retracted", "**declined**"}, StatusDeclined, false},
 	{[]string{"**closed**"}, StatusDeclined, false},
 
 	// Likely accept/decline patterns
@@ -122,6 +164,7 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 
 	// Find date header
 	var meetingDate time.Time
+	var reviewer string
 	for _, line := range lines {
 		dateStr := extractDateFromLine(line)
 		if dateStr != "" {
@@ -133,6 +176,7 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 					"error", err)
 				continue
 			}
+			reviewer = extractReviewerFromLine(line)
 			break
 		}
 	}
@@ -154,12 +198,7 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 		if status, ok := detectSectionHeader(line); ok {
 			// Save previous proposal before changing section
 			if currentProposal != nil && currentProposal.status != "" {
-				changes = append(changes, ProposalChange{
-					IssueNumber:   currentProposal.issueNumber,
-					Title:         currentProposal.title,
-					CurrentStatus: currentProposal.status,
-					ChangedAt:     meetingDate,
-				})
+				changes = append(changes, currentProposal.change(meetingDate, reviewer))
 				currentProposal = nil
 			}
 			currentSectionStatus = status
@@ -170,12 +209,7 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 		if issueNumber, title, ok := parseProposalLine(line); ok {
 			// Save previous proposal if it had a status change
 			if currentProposal != nil && currentProposal.status != "" {
-				changes = append(changes, ProposalChange{
-					IssueNumber:   currentProposal.issueNumber,
-					Title:         currentProposal.title,
-					CurrentStatus: currentProposal.status,
-					ChangedAt:     meetingDate,
-				})
+				changes = append(changes, currentProposal.change(meetingDate, reviewer))
 			}
 
 			currentProposal = &proposalContext{
@@ -186,6 +220,11 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 			continue
 		}
 
+		// Collect issues referenced in the proposal's sub-items
+		if currentProposal != nil && (strings.HasPrefix(line, "  ") || strings.HasPrefix(line, "\t")) {
+			currentProposal.addRelatedIssues(line)
+		}
+
 		// Fallback: Check for status keywords in indented lines when no section header exists
 		// Only check indented lines (action lines under proposals)
 		// Section headers like "**Accepted**" start at column 0, while action lines
@@ -199,12 +238,7 @@ func (p *MinutesParser) Parse(comment string, commentedAt time.Time) ([]Proposal
 
 	// Don't forget the last proposal
 	if currentProposal != nil && currentProposal.status != "" {
-		changes = append(changes, ProposalChange{
-			IssueNumber:   currentProposal.issueNumber,
-			Title:         currentProposal.title,
-			CurrentStatus: currentProposal.status,
-			ChangedAt:     meetingDate,
-		})
+		changes = append(changes, currentProposal.change(meetingDate, reviewer))
 	}
 
 	return changes, nil
@@ -219,9 +253,70 @@ func truncate(s string, n int) string {
 }
 
 type proposalContext struct {
-	title       string
-	status      Status
-	issueNumber int
+	title         string
+	status        Status
+	relatedIssues []int
+	issueNumber   int
+}
+
+// change returns the ProposalChange recorded for the proposal.
+func (c *proposalContext) change(meetingDate time.Time, reviewer string) ProposalChange {
+	return ProposalChange{
+		IssueNumber:   c.issueNumber,
+		Title:         c.title,
+		CurrentStatus: c.status,
+		ChangedAt:     meetingDate,
+		Reviewer:      reviewer,
+		RelatedIssues: c.relatedIssues,
+	}
+}
+
+// addRelatedIssues records the issues referenced in line, in order of
+// appearance, skipping the proposal itself and issues already recorded.
+func (c *proposalContext) addRelatedIssues(line string) {
+	for _, issue := range extractIssueReferences(line) {
+		if issue == c.issueNumber || slices.Contains(c.relatedIssues, issue) {
+			continue
+		}
+		c.relatedIssues = append(c.relatedIssues, issue)
+	}
+}
+
+// issueReferenceRe matches "#NNNN" references to issues in the same
+// repository. References qualified with another repository
+// (e.g. "owner/repo#NNNN") are not matched.
+var issueReferenceRe = regexp.MustCompile(`(?:^|[^\w/#&])#(\d+)\b`)
+
+// extractIssueReferences returns the issue numbers referenced in line.
+func extractIssueReferences(line string) []int {
+	var issues []int
+	for _, m := range issueReferenceRe.FindAllStringSubmatch(line, -1) {
+		issue, err := strconv.Atoi(m[1])
+		if err != nil || issue == 0 {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// isMinutesComment reports whether body is a proposal review minutes
+// comment: a "**YYYY-MM-DD** / **@handle**" header naming at least one
+// reviewer must come before the first proposal line. Free-form notes may
+// precede the header, but a comment whose proposal-like bullets come first,
+// or that has no header at all, is not minutes.
+func isMinutesComment(body string) bool {
+	for line := range strings.SplitSeq(body, "\n") {
+		if dateStr := extractDateFromLine(line); dateStr != "" {
+			if _, err := time.Parse("2006-01-02", dateStr); err == nil {
+				return extractReviewerFromLine(line) != ""
+			}
+		}
+		if _, _, ok := parseProposalLine(line); ok {
+			return false
+		}
+	}
+	return false
 }
 
 // extractDateFromLine extracts a date string (YYYY-MM-DD format) from a line.
@@ -249,6 +344,29 @@ func extractDateFromLine(line string) string {
 	return ""
 }
 
+// extractReviewerFromLine extracts the first GitHub login after the date in a
+// minutes header such as "**2026-01-30** / **@rsc**" or
+// "**2022-01-12 / @rsc, @griesemer**". The login is returned without the
+// leading "@"; an empty string is returned if the header names nobody.
+func extractReviewerFromLine(line string) string {
+	_, rest, ok := strings.Cut(line, "/")
+	if !ok {
+		return ""
+	}
+	rest = strings.TrimLeft(rest, " *")
+	login, ok := strings.CutPrefix(rest, "@")
+	if !ok {
+		return ""
+	}
+	end := strings.IndexFunc(login, func(r rune) bool {
+		return r != '-' && (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z')
+	})
+	if end >= 0 {
+		login = login[:end]
+	}
+	return login
+}
+
 // isDateFormat checks if a string matches YYYY-MM-DD format.
 func isDateFormat(s string) bool {
 	if len(s) != 10 {
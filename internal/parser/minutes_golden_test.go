@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"testing"
 	"time"
@@ -95,6 +96,9 @@ func TestMinutesParser_Golden(t *testing.T) {
 				if !got[i].ChangedAt.Equal(want[i].ChangedAt) {
 					t.Errorf("change[%d].ChangedAt = %v, want %v", i, got[i].ChangedAt, want[i].ChangedAt)
 				}
+				if !slices.Equal(got[i].RelatedIssues, want[i].RelatedIssues) {
+					t.Errorf("change[%d].RelatedIssues = %v, want %v", i, got[i].RelatedIssues, want[i].RelatedIssues)
+				}
 			}
 		})
 	}
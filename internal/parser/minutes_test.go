@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"slices"
 	"testing"
 	"time"
 
@@ -332,7 +333,7 @@ Just some random text
 			},
 		},
 		{
-			name: "proposal retracted declined",
+			name: "proposal retracted by author",
 			comment: `**2020-03-11** / @rsc, @griesemer
 
 - #37642 **runtime: make print/println print interfaces**
@@ -353,6 +354,184 @@ Just some random text
 				},
 			},
 		},
+		{
+			name: "standalone retracted",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37643 **fmt: add Appendf**
+  - retracted
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37643,
+					title:         "fmt: add Appendf",
+					currentStatus: parser.StatusRetracted,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "bold retracted",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37644 **io: add NopWriter**
+  - **retracted**
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37644,
+					title:         "io: add NopWriter",
+					currentStatus: parser.StatusRetracted,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "removed from proposal process",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37645 **spec: add sum types**
+  - removed from proposal process
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37645,
+					title:         "spec: add sum types",
+					currentStatus: parser.StatusRemoved,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "standalone removed",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37646 **os: add Touch**
+  - removed
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37646,
+					title:         "os: add Touch",
+					currentStatus: parser.StatusRemoved,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "bold removed",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37647 **net: add Dial6**
+  - **removed**
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37647,
+					title:         "net: add Dial6",
+					currentStatus: parser.StatusRemoved,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "removed section header",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+**Removed**
+
+- #37648 **x/net: add quic**
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37648,
+					title:         "x/net: add quic",
+					currentStatus: parser.StatusRemoved,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "removed label is not a status",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37649 **bytes: add Clone**
+  - removed FinalCommentPeriod label
+  - **accepted**
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37649,
+					title:         "bytes: add Clone",
+					currentStatus: parser.StatusAccepted,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "retracted mention is not a status",
+			comment: `**2020-03-11** / @rsc, @griesemer
+
+- #37650 **strings: add Lines**
+  - retracted counter-proposal discussed; **likely accept**
+`,
+			commentedAt: time.Date(2020, 3, 11, 12, 0, 0, 0, time.UTC),
+			want: []struct {
+				changedAt     time.Time
+				title         string
+				currentStatus parser.Status
+				issueNumber   int
+			}{
+				{
+					issueNumber:   37650,
+					title:         "strings: add Lines",
+					currentStatus: parser.StatusLikelyAccept,
+					changedAt:     time.Date(2020, 3, 11, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -498,3 +677,182 @@ func TestMinutesParser_Parse_RealComment(t *testing.T) {
 		}
 	}
 }
+
+func TestMinutesParser_Parse_Reviewer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		header string
+		want   string
+	}{
+		{
+			name:   "bold date and bold login",
+			header: "**2026-01-30** / **@rsc**",
+			want:   "rsc",
+		},
+		{
+			name:   "bold header with several attendees",
+			header: "**2022-01-12 / @rsc, @griesemer, @ianlancetaylor**",
+			want:   "rsc",
+		},
+		{
+			name:   "plain date with bold attendees",
+			header: "2019-09-03 / **@bradfitz, @griesemer**",
+			want:   "bradfitz",
+		},
+		{
+			name:   "login with hyphen and digits",
+			header: "**2026-01-30** / @go-bot2, @rsc",
+			want:   "go-bot2",
+		},
+		{
+			name:   "date only",
+			header: "**2026-01-30**",
+			want:   "",
+		},
+		{
+			name:   "malformed attendee list",
+			header: "**2026-01-30** / rsc",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			comment := tt.header + "\n\n- #11111 **test proposal**\n  - **accepted**\n"
+			p := parser.NewMinutesParser()
+			got, err := p.Parse(comment, time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if len(got) != 1 {
+				t.Fatalf("got %d changes, want 1", len(got))
+			}
+			if got[0].Reviewer != tt.want {
+				t.Errorf("Reviewer = %q, want %q", got[0].Reviewer, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinutesParser_Parse_RelatedIssues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		comment string
+		want    map[int][]int
+	}{
+		{
+			name: "sub-item references in order of appearance",
+			comment: `**2022-02-02 / @rsc, @griesemer**
+
+- #51082 **spec: generics**
+  - duplicate of #44551; see also [#43698](https://github.com/golang/go/issues/43698)
+  - **declined**
+`,
+			want: map[int][]int{51082: {44551, 43698}},
+		},
+		{
+			name: "deduplicates and skips the proposal itself",
+			comment: `**2022-02-02 / @rsc**
+
+- #51082 **spec: generics**
+  - hold for #44551 (see #51082)
+  - still waiting for #44551
+  - **declined**
+`,
+			want: map[int][]int{51082: {44551}},
+		},
+		{
+			name: "references belong to their own proposal",
+			comment: `**2022-02-02 / @rsc**
+
+**Declined**
+
+- #100 **first**
+  - duplicate of #200
+- #300 **second**
+  - blocked on #400
+`,
+			want: map[int][]int{100: {200}, 300: {400}},
+		},
+		{
+			name: "ignores references to other repositories",
+			comment: `**2022-02-02 / @rsc**
+
+- #100 **first**
+  - see golang/tools#200 and #300
+  - **accepted**
+`,
+			want: map[int][]int{100: {300}},
+		},
+		{
+			name: "no references",
+			comment: `**2022-02-02 / @rsc**
+
+- #100 **first**
+  - **accepted**
+`,
+			want: map[int][]int{100: nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := parser.NewMinutesParser()
+			got, err := p.Parse(tt.comment, time.Date(2022, 2, 2, 12, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d changes, want %d", len(got), len(tt.want))
+			}
+			for _, change := range got {
+				want, ok := tt.want[change.IssueNumber]
+				if !ok {
+					t.Errorf("unexpected change for #%d", change.IssueNumber)
+					continue
+				}
+				if !slices.Equal(change.RelatedIssues, want) {
+					t.Errorf("#%d RelatedIssues = %v, want %v", change.IssueNumber, change.RelatedIssues, want)
+				}
+			}
+		})
+	}
+}
+
+func TestStatus_IsTerminal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status       parser.Status
+		wantTerminal bool
+	}{
+		{parser.StatusAccepted, true},
+		{parser.StatusDeclined, true},
+		{parser.StatusLikelyAccept, false},
+		{parser.StatusLikelyDecline, false},
+		{parser.StatusActive, false},
+		{parser.StatusHold, false},
+		{parser.StatusDiscussions, false},
+		{parser.StatusRetracted, true},
+		{parser.StatusRemoved, true},
+		{parser.Status(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.status.IsTerminal(); got != tt.wantTerminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.wantTerminal)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Retry constants.
+const (
+	// maxSecondaryRateLimitRetries is the number of times a request is retried
+	// after hitting a secondary rate limit.
+	maxSecondaryRateLimitRetries = 3
+
+	// defaultSecondaryRateLimitDelay is the wait before the first retry when
+	// the response has no Retry-After header. GitHub recommends waiting at
+	// least one minute; the delay doubles on each further retry.
+	defaultSecondaryRateLimitDelay = time.Minute
+
+	// DefaultRetryBaseDelay is the wait before the first retry of a transient
+	// error when IssueParserConfig.RetryBaseDelay is zero.
+	DefaultRetryBaseDelay = time.Second
+)
+
+// secondaryRateLimitMarkers are substrings of the error message GitHub
+// returns for secondary (abuse detection) rate limits.
+var secondaryRateLimitMarkers = []string{
+	"secondary rate limit",
+	"abuse detection",
+}
+
+// doRequest executes req, retrying transient errors and secondary rate
+// limits with backoff.
+//
+// Transient errors (429, 500 and 503) are retried up to maxRetries times,
+// waiting for Retry-After when present and otherwise backing off
+// exponentially from retryBaseDelay.
+//
+// Secondary rate limits are returned as 403 with a Retry-After header or a
+// specific message, and unlike a genuine permission 403 they succeed after
+// waiting. Any other response is returned as is.
+// Every attempt is subject to the configured concurrency limit and
+// politeness delay, and waiting aborts as soon as the context is done.
+func (ip *IssueParser) doRequest(req *http.Request) (*http.Response, error) {
+	var transientRetries, secondaryRetries int
+	for {
+		resp, err := ip.throttledDo(req.Clone(req.Context()))
+		if err != nil {
+			return nil, err
+		}
+
+		var delay time.Duration
+		switch {
+		case isTransientStatus(resp.StatusCode) && transientRetries < ip.maxRetries:
+			delay = transientRetryDelay(resp.Header, ip.retryBaseDelay, transientRetries)
+			transientRetries++
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			ip.logger.Debug("transient GitHub error, retrying",
+				"status", resp.StatusCode,
+				"attempt", transientRetries,
+				"delay", delay)
+
+		case resp.StatusCode == http.StatusForbidden:
+			body, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			if !isSecondaryRateLimit(resp.Header, body) || secondaryRetries >= maxSecondaryRateLimitRetries {
+				return resp, nil
+			}
+			delay = secondaryRateLimitDelay(resp.Header, secondaryRetries)
+			secondaryRetries++
+			ip.logger.Warn("hit GitHub secondary rate limit, retrying",
+				"attempt", secondaryRetries,
+				"delay", delay)
+
+		default:
+			return resp, nil
+		}
+
+		if err := ip.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isTransientStatus reports whether a response status is a transient GitHub
+// error worth retrying.
+func isTransientStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable:
+		return true
+	default:
+		return false
+	}
+}
+
+// transientRetryDelay returns how long to wait before retrying a transient
+// error. It honors Retry-After and otherwise backs off exponentially from base.
+func transientRetryDelay(header http.Header, base time.Duration, attempt int) time.Duration {
+	if d, ok := retryAfter(header); ok {
+		return d
+	}
+	return base << attempt
+}
+
+// throttledDo sends req once a request slot is available and the politeness
+// delay has elapsed. The slot is held until the response headers arrive.
+func (ip *IssueParser) throttledDo(req *http.Request) (*http.Response, error) {
+	release, err := ip.throttle.acquire(req.Context(), ip.sleep)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return ip.httpClient.Do(req)
+}
+
+// isSecondaryRateLimit reports whether a 403 response is a secondary rate
+// limit rather than a permission error.
+func isSecondaryRateLimit(header http.Header, body []byte) bool {
+	if header.Get("Retry-After") != "" {
+		return true
+	}
+	msg := strings.ToLower(string(body))
+	for _, marker := range secondaryRateLimitMarkers {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// secondaryRateLimitDelay returns how long to wait before retrying.
+// It honors Retry-After (in seconds) and otherwise backs off exponentially
+// from defaultSecondaryRateLimitDelay.
+func secondaryRateLimitDelay(header http.Header, attempt int) time.Duration {
+	if d, ok := retryAfter(header); ok {
+		return d
+	}
+	return defaultSecondaryRateLimitDelay << attempt
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an
+// HTTP date. Dates in the past yield zero.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
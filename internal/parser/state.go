@@ -2,8 +2,14 @@ package parser
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io/fs"
+	"maps"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,6 +21,7 @@ type State struct {
 	LastProcessedAt  time.Time      `json:"lastProcessedAt"`
 	ProposalStatuses map[int]Status `json:"proposalStatuses,omitempty"`
 	LastCommentID    string         `json:"lastCommentId"`
+	ETag             string         `json:"etag,omitempty"`
 	IsFresh          bool           `json:"-"`
 }
 
@@ -58,6 +65,51 @@ func (sm *StateManager) LoadState() (*State, error) {
 	return &state, nil
 }
 
+// Validate は状態の整合性を検証する
+// LastCommentIDが数値であること、カーソルとLastProcessedAtが揃っていること、
+// ProposalStatusesが正のissue番号と既知のステータスのみを含むことを確認する
+func (s *State) Validate() error {
+	var errs []error
+
+	if s.LastCommentID != "" {
+		if _, err := strconv.ParseInt(s.LastCommentID, 10, 64); err != nil {
+			errs = append(errs, fmt.Errorf("lastCommentId %q is not a numeric comment ID", s.LastCommentID))
+		}
+		if s.LastProcessedAt.IsZero() {
+			errs = append(errs, errors.New("lastProcessedAt is missing while lastCommentId is set"))
+		}
+	} else if !s.LastProcessedAt.IsZero() {
+		errs = append(errs, errors.New("lastCommentId is missing while lastProcessedAt is set"))
+	}
+
+	for _, issue := range slices.Sorted(maps.Keys(s.ProposalStatuses)) {
+		status := s.ProposalStatuses[issue]
+		if issue <= 0 {
+			errs = append(errs, fmt.Errorf("proposalStatuses has invalid issue number %d", issue))
+		}
+		if !status.IsKnown() {
+			errs = append(errs, fmt.Errorf("proposalStatuses[%d] has unknown status %q", issue, status))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidETag はETagが引用符で囲まれた正しい形式（弱いETagの W/ 接頭辞を含む）か判定する
+func ValidETag(etag string) bool {
+	opaque := strings.TrimPrefix(etag, "W/")
+	if len(opaque) < 2 || opaque[0] != '"' || opaque[len(opaque)-1] != '"' {
+		return false
+	}
+	for _, c := range []byte(opaque[1 : len(opaque)-1]) {
+		// etagc = %x21 / %x23-7E / obs-text (RFC 9110)
+		if c == '"' || c < 0x21 || c == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
 // SaveState は状態をstate.jsonに保存する
 func (sm *StateManager) SaveState(state *State) error {
 	data, err := json.MarshalIndent(state, "", "  ")
@@ -344,3 +344,79 @@ func TestStateManager_UpdateState(t *testing.T) {
 		})
 	}
 }
+
+func TestState_Validate(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		state   parser.State
+		name    string
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			state: parser.State{LastCommentID: "123", LastProcessedAt: at, ProposalStatuses: map[int]parser.Status{1: parser.StatusAccepted}},
+		},
+		{
+			name:  "empty",
+			state: parser.State{},
+		},
+		{
+			name:    "non-numeric comment ID",
+			state:   parser.State{LastCommentID: "abc", LastProcessedAt: at},
+			wantErr: true,
+		},
+		{
+			name:    "cursor without time",
+			state:   parser.State{LastCommentID: "123"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown status",
+			state:   parser.State{LastCommentID: "123", LastProcessedAt: at, ProposalStatuses: map[int]parser.Status{1: "maybe"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tt.state.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		etag string
+		want bool
+	}{
+		{name: "strong", etag: `"abc123"`, want: true},
+		{name: "weak", etag: `W/"abc123"`, want: true},
+		{name: "empty opaque tag", etag: `""`, want: true},
+		{name: "empty", etag: "", want: false},
+		{name: "unquoted", etag: "abc123", want: false},
+		{name: "missing closing quote", etag: `"abc123`, want: false},
+		{name: "embedded quote", etag: `"abc"123"`, want: false},
+		{name: "embedded space", etag: `"abc 123"`, want: false},
+		{name: "lowercase weak prefix", etag: `w/"abc123"`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := parser.ValidETag(tt.etag); got != tt.want {
+				t.Errorf("ValidETag(%q) = %v, want %v", tt.etag, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// throttle limits the number of concurrent GitHub requests and spaces
+// consecutive requests by a politeness delay. The zero value imposes no limits.
+type throttle struct {
+	// slots holds one token per in-flight request; nil means unlimited.
+	slots chan struct{}
+	// delay is the minimum time between the starts of two requests.
+	delay time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// newThrottle creates a throttle allowing maxConcurrent in-flight requests
+// (unlimited if less than 1) started at least delay apart.
+func newThrottle(maxConcurrent int, delay time.Duration) *throttle {
+	t := &throttle{delay: delay}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+// acquire waits for a free slot and for the politeness delay since the
+// previous request, using sleep to wait. The returned function releases the
+// slot and must be called once the request has completed.
+func (t *throttle) acquire(ctx context.Context, sleep func(context.Context, time.Duration) error) (func(), error) {
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	}
+
+	if t.delay > 0 {
+		// Reserve the next start time so that concurrent callers are spaced too.
+		t.mu.Lock()
+		now := time.Now()
+		start := now
+		if t.next.After(now) {
+			start = t.next
+		}
+		t.next = start.Add(t.delay)
+		t.mu.Unlock()
+
+		if wait := start.Sub(now); wait > 0 {
+			if err := sleep(ctx, wait); err != nil {
+				release()
+				return nil, err
+			}
+		}
+	}
+
+	return release, nil
+}
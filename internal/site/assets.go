@@ -0,0 +1,84 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// hashedAssets lists the frontend assets referenced by every page that get
+// content-hashed names with WithHashedAssets.
+var hashedAssets = []string{"styles.css", "components.js"}
+
+// assetHashLength is the number of hex digits of the content hash used in
+// hashed asset filenames.
+const assetHashLength = 8
+
+// hashedAssetFilename returns name with the short content hash inserted
+// before its extension, e.g. "styles.0123abcd.css".
+func hashedAssetFilename(name string, data []byte) string {
+	sum := sha256.Sum256(data)
+	ext := filepath.Ext(name)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(name, ext), hex.EncodeToString(sum[:])[:assetHashLength], ext)
+}
+
+// writeHashedAssets copies each of hashedAssets found in the dist directory
+// to its hashed filename and returns the mapping from the stable name to the
+// hashed one. Assets not built yet are skipped and keep their stable name.
+func (g *Generator) writeHashedAssets(ctx context.Context) (map[string]string, error) {
+	names := make(map[string]string, len(hashedAssets))
+	for _, name := range hashedAssets {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(filepath.Join(g.distDir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		hashed := hashedAssetFilename(name, data)
+		hashedPath := filepath.Join(g.distDir, hashed)
+		if err := os.WriteFile(hashedPath, data, filePerm); err != nil {
+			_ = os.Remove(hashedPath)
+			return nil, fmt.Errorf("failed to write %s: %w", hashed, err)
+		}
+		names[name] = hashed
+	}
+	return names, nil
+}
+
+// withHashedAssets returns component with references to the stable asset
+// names replaced by their hashed names.
+func (g *Generator) withHashedAssets(component templ.Component) templ.Component {
+	if len(g.assetNames) == 0 {
+		return component
+	}
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		var buf bytes.Buffer
+		if err := component.Render(ctx, &buf); err != nil {
+			return err
+		}
+
+		page := buf.Bytes()
+		for name, hashed := range g.assetNames {
+			for _, attr := range []string{"href", "src"} {
+				page = bytes.ReplaceAll(page, fmt.Appendf(nil, `%s="/%s"`, attr, name), fmt.Appendf(nil, `%s="/%s"`, attr, hashed))
+			}
+		}
+		_, err := w.Write(page)
+		return err
+	})
+}
@@ -0,0 +1,97 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_HashedAssets(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   12345,
+					Title:         "proposal: hashed assets",
+					CurrentStatus: parser.StatusAccepted,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	stylesRe := regexp.MustCompile(`href="/(styles\.[0-9a-f]{8}\.css)"`)
+	scriptRe := regexp.MustCompile(`src="/(components\.[0-9a-f]{8}\.js)"`)
+	pages := []string{"index.html", filepath.Join("2026", "w05", "index.html"), filepath.Join("2026", "w05", "12345.html")}
+
+	// generate builds a site with the given stylesheet and returns the
+	// hashed stylesheet name referenced by every page
+	generate := func(t *testing.T, styles string) string {
+		t.Helper()
+
+		distDir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(distDir, "styles.css"), []byte(styles), filePerm); err != nil {
+			t.Fatalf("failed to write styles.css: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(distDir, "components.js"), []byte("export {};\n"), filePerm); err != nil {
+			t.Fatalf("failed to write components.js: %v", err)
+		}
+
+		gen := NewGenerator(WithDistDir(distDir), WithHashedAssets(true))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		var stylesName string
+		for _, page := range pages {
+			html, err := os.ReadFile(filepath.Join(distDir, page))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", page, err)
+			}
+			m := stylesRe.FindSubmatch(html)
+			if m == nil {
+				t.Fatalf("%s should reference a hashed stylesheet", page)
+			}
+			if stylesName != "" && string(m[1]) != stylesName {
+				t.Errorf("%s references %s, other pages %s", page, m[1], stylesName)
+			}
+			stylesName = string(m[1])
+
+			s := scriptRe.FindSubmatch(html)
+			if s == nil {
+				t.Fatalf("%s should reference a hashed script", page)
+			}
+			if _, err := os.Stat(filepath.Join(distDir, string(s[1]))); err != nil {
+				t.Errorf("referenced script %s should exist: %v", s[1], err)
+			}
+		}
+
+		written, err := os.ReadFile(filepath.Join(distDir, stylesName))
+		if err != nil {
+			t.Fatalf("referenced stylesheet %s should exist: %v", stylesName, err)
+		}
+		if string(written) != styles {
+			t.Errorf("%s = %q, want %q", stylesName, written, styles)
+		}
+		return stylesName
+	}
+
+	first := generate(t, "body { color: red; }\n")
+	second := generate(t, "body { color: blue; }\n")
+	if first == second {
+		t.Errorf("hashed name %s should change when the content changes", first)
+	}
+	if again := generate(t, "body { color: red; }\n"); again != first {
+		t.Errorf("hashed name = %s, want stable %s for the same content", again, first)
+	}
+}
@@ -0,0 +1,78 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gopherlibs/feedhub/feedhub"
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+// atomFeedFilename is the name of the Atom feed file.
+const atomFeedFilename = "atom.xml"
+
+// GenerateAtom generates an Atom 1.0 feed from the given weekly contents.
+// Entries match the items of GenerateFeed: the most recent weeks, newest
+// first. Each entry is updated at the week's latest proposal change.
+func (fg *FeedGenerator) GenerateAtom(ctx context.Context, weeks []*content.WeeklyContent) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	feed := &feedhub.Feed{
+		Title:       fg.siteTitle,
+		Link:        &feedhub.Link{Href: fg.siteURL + "/"},
+		Description: fg.siteDesc,
+		// Atom requires an author on the feed or on every entry
+		Author:  &feedhub.Author{Name: fg.authorName, Email: fg.authorEmail},
+		Created: now,
+		Updated: now,
+	}
+
+	itemTitle, err := fg.parseItemTitle()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, week := range fg.latestWeeks(weeks) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := fg.weekToFeedItem(week)
+		if itemTitle != nil {
+			title, err := fg.renderItemTitle(itemTitle, week)
+			if err != nil {
+				return nil, err
+			}
+			item.Title = title
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	atom, err := feed.ToAtom()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate Atom feed: %w", err)
+	}
+	return []byte(atom), nil
+}
+
+// generateAtomFeed writes the Atom feed (atom.xml).
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateAtomFeed(ctx context.Context, weeks []*content.WeeklyContent) error {
+	data, err := g.feedGenerator().GenerateAtom(ctx, weeks)
+	if err != nil {
+		return fmt.Errorf("failed to generate Atom feed: %w", err)
+	}
+
+	feedPath := filepath.Join(g.distDir, atomFeedFilename)
+	if err := os.WriteFile(feedPath, data, filePerm); err != nil {
+		_ = os.Remove(feedPath)
+		return fmt.Errorf("failed to write %s: %w", atomFeedFilename, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+// atomFeedDoc is the subset of an Atom 1.0 feed checked by tests.
+type atomFeedDoc struct {
+	XMLName xml.Name       `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string         `xml:"id"`
+	Title   string         `xml:"title"`
+	Updated string         `xml:"updated"`
+	Entries []atomEntryDoc `xml:"entry"`
+}
+
+// atomEntryDoc is an Atom entry.
+type atomEntryDoc struct {
+	ID      string `xml:"id"`
+	Title   string `xml:"title"`
+	Updated string `xml:"updated"`
+	Summary string `xml:"summary"`
+	Link    struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+	} `xml:"link"`
+}
+
+func TestFeedGenerator_GenerateAtom(t *testing.T) {
+	t.Parallel()
+
+	// Weeks in ascending order; each week's latest change is on its Wednesday
+	weeks := make([]*content.WeeklyContent, 0, 3)
+	for week := 3; week <= 5; week++ {
+		latest := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 7*(week-2))
+		weeks = append(weeks, &content.WeeklyContent{
+			Year: 2026,
+			Week: week,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 100 + week, Title: "proposal: older", CurrentStatus: parser.StatusActive, ChangedAt: latest.AddDate(0, 0, -1)},
+				{IssueNumber: 200 + week, Title: fmt.Sprintf("proposal: week %d", week), CurrentStatus: parser.StatusAccepted, ChangedAt: latest},
+			},
+		})
+	}
+
+	fg := NewFeedGenerator(WithSiteURL("https://example.com"))
+	data, err := fg.GenerateAtom(context.Background(), weeks)
+	if err != nil {
+		t.Fatalf("GenerateAtom() error = %v", err)
+	}
+
+	var feed atomFeedDoc
+	if err := xml.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("Atom feed is not valid: %v\n%s", err, data)
+	}
+	if feed.ID == "" || feed.Title == "" {
+		t.Errorf("feed id and title are required, got id=%q title=%q", feed.ID, feed.Title)
+	}
+	if _, err := time.Parse(time.RFC3339, feed.Updated); err != nil {
+		t.Errorf("feed updated = %q is not RFC 3339: %v", feed.Updated, err)
+	}
+	if len(feed.Entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(feed.Entries))
+	}
+
+	for i, entry := range feed.Entries {
+		week := 5 - i
+		if want := fmt.Sprintf("https://example.com/2026/w%02d/", week); entry.Link.Href != want {
+			t.Errorf("entry %d link = %q, want %q (newest first)", i, entry.Link.Href, want)
+		}
+		if entry.ID == "" || entry.Title == "" || entry.Summary == "" {
+			t.Errorf("entry %d has empty required fields: %+v", i, entry)
+		}
+		wantUpdated := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 7*(week-2)).Format(time.RFC3339)
+		if entry.Updated != wantUpdated {
+			t.Errorf("entry %d updated = %q, want latest ChangedAt %q", i, entry.Updated, wantUpdated)
+		}
+	}
+}
+
+func TestGenerator_GenerateAtom(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 12345, Title: "proposal: atom", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, atomFeedFilename))
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", atomFeedFilename, err)
+	}
+	var feed atomFeedDoc
+	if err := xml.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("%s is not valid Atom: %v", atomFeedFilename, err)
+	}
+	if len(feed.Entries) != 1 {
+		t.Errorf("got %d entries, want 1", len(feed.Entries))
+	}
+
+	html, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read index.html: %v", err)
+	}
+	if !strings.Contains(string(html), `<link rel="alternate" type="application/atom+xml"`) {
+		t.Error("index.html should include Atom autodiscovery link")
+	}
+}
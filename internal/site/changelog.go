@@ -0,0 +1,59 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+// changelogFilename is the name of the plain-text changelog file.
+const changelogFilename = "changelog.txt"
+
+// BuildChangelog builds a plain-text changelog listing every status transition
+// in chronological order, one per line, in the form:
+//
+//	YYYY-MM-DD #NNNN Title: prev -> current
+//
+// Newly proposed entries (no previous status) are shown as "new".
+func BuildChangelog(weeks []*content.WeeklyContent) []byte {
+	var proposals []content.ProposalContent
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		proposals = append(proposals, week.Proposals...)
+	}
+	content.SortProposals(proposals)
+
+	var sb strings.Builder
+	for _, p := range proposals {
+		prev := string(p.PreviousStatus)
+		if prev == "" {
+			prev = "new"
+		}
+		fmt.Fprintf(&sb, "%s #%d %s: %s -> %s\n",
+			p.ChangedAt.UTC().Format("2006-01-02"), p.IssueNumber, p.Title, prev, p.CurrentStatus)
+	}
+
+	return []byte(sb.String())
+}
+
+// generateChangelog writes the plain-text changelog (changelog.txt).
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateChangelog(ctx context.Context, weeks []*content.WeeklyContent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	changelogPath := filepath.Join(g.distDir, changelogFilename)
+	if err := os.WriteFile(changelogPath, BuildChangelog(weeks), filePerm); err != nil {
+		_ = os.Remove(changelogPath)
+		return fmt.Errorf("failed to write %s: %w", changelogFilename, err)
+	}
+
+	return nil
+}
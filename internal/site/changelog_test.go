@@ -0,0 +1,97 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_GenerateChangelog(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    22222,
+					Title:          "proposal: second week",
+					PreviousStatus: parser.StatusLikelyAccept,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    11111,
+					Title:          "proposal: first week",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusLikelyAccept,
+					ChangedAt:      time.Date(2026, 1, 22, 12, 0, 0, 0, time.UTC),
+				},
+				{
+					IssueNumber:   33333,
+					Title:         "proposal: brand new",
+					CurrentStatus: parser.StatusDiscussions,
+					ChangedAt:     time.Date(2026, 1, 21, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(
+		WithDistDir(distDir),
+		WithChangelog(true),
+	)
+
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, "changelog.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read changelog.txt: %v", err)
+	}
+
+	want := []string{
+		"2026-01-21 #33333 proposal: brand new: new -> discussions",
+		"2026-01-22 #11111 proposal: first week: active -> likely_accept",
+		"2026-01-28 #22222 proposal: second week: likely_accept -> accepted",
+	}
+	got := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(got) != len(want) {
+		t.Fatalf("changelog has %d lines, want %d:\n%s", len(got), len(want), data)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestGenerator_GenerateChangelogDisabled(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir))
+
+	if err := gen.Generate(context.Background(), nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(distDir, "changelog.txt")); !os.IsNotExist(err) {
+		t.Errorf("changelog.txt should not be generated when disabled, stat err = %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+)
+
+// dataExportFilename is the name of the JSON export of all content.
+const dataExportFilename = "data.json"
+
+// generateDataExport writes all weeks as JSON (data.json) for external tools.
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateDataExport(ctx context.Context, weeks []*content.WeeklyContent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := content.WriteJSON(&buf, weeks); err != nil {
+		return err
+	}
+
+	exportPath := filepath.Join(g.distDir, dataExportFilename)
+	if err := os.WriteFile(exportPath, buf.Bytes(), filePerm); err != nil {
+		_ = os.Remove(exportPath)
+		return fmt.Errorf("failed to write %s: %w", dataExportFilename, err)
+	}
+	return nil
+}
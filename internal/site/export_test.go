@@ -0,0 +1,72 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_GenerateDataExport(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   12345,
+					Title:         "proposal: export",
+					CurrentStatus: parser.StatusAccepted,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+					Links:         []content.Link{{Title: "proposal issue", URL: "https://github.com/golang/go/issues/12345"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "writes data.json when enabled", enabled: true},
+		{name: "skips data.json when disabled", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(WithDistDir(distDir), WithDataExport(tt.enabled))
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			f, err := os.Open(filepath.Join(distDir, dataExportFilename))
+			if !tt.enabled {
+				if !os.IsNotExist(err) {
+					t.Errorf("%s should not be generated when disabled, open err = %v", dataExportFilename, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to open %s: %v", dataExportFilename, err)
+			}
+			defer f.Close()
+
+			got, err := content.ReadJSON(f)
+			if err != nil {
+				t.Fatalf("ReadJSON() error = %v", err)
+			}
+			if len(got) != 1 || len(got[0].Proposals) != 1 || got[0].Proposals[0].IssueNumber != 12345 {
+				t.Errorf("unexpected export: %+v", got)
+			}
+		})
+	}
+}
@@ -3,17 +3,21 @@ package site
 
 import (
 	"context"
+	"encoding/xml"
 	"fmt"
 	"html"
+	"slices"
 	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/gopherlibs/feedhub/feedhub"
 	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
 )
 
-// MaxFeedItems is the maximum number of weekly items to include in the RSS feed.
+// MaxFeedItems is the default maximum number of weekly items to include in the RSS feed.
 const MaxFeedItems = 20
 
 // FeedGenerator handles RSS feed generation.
@@ -23,6 +27,11 @@ type FeedGenerator struct {
 	siteDesc    string
 	authorName  string
 	authorEmail string
+	useUpdated  bool
+	maxTitleLen int
+	stripPrefix bool
+	maxItems    int
+	itemTitle   string
 }
 
 // FeedOption is a functional option for configuring FeedGenerator.
@@ -57,6 +66,52 @@ func WithAuthor(name, email string) FeedOption {
 	}
 }
 
+// WithFeedUpdatedAt makes item update dates prefer a proposal's updated_at
+// (last summary/link edit) over its changed_at.
+func WithFeedUpdatedAt(enabled bool) FeedOption {
+	return func(fg *FeedGenerator) {
+		fg.useUpdated = enabled
+	}
+}
+
+// WithFeedMaxTitleLength truncates proposal titles longer than n runes in
+// item descriptions. Values less than 1 disable truncation.
+func WithFeedMaxTitleLength(n int) FeedOption {
+	return func(fg *FeedGenerator) {
+		fg.maxTitleLen = n
+	}
+}
+
+// WithFeedStripTitlePrefix removes the redundant "proposal:" prefix from
+// proposal titles in item descriptions (see content.StripProposalPrefix).
+func WithFeedStripTitlePrefix(enabled bool) FeedOption {
+	return func(fg *FeedGenerator) {
+		fg.stripPrefix = enabled
+	}
+}
+
+// WithMaxItems sets the maximum number of weekly items in the feed; the
+// newest weeks are kept. Values less than 1 use MaxFeedItems.
+func WithMaxItems(n int) FeedOption {
+	return func(fg *FeedGenerator) {
+		fg.maxItems = n
+	}
+}
+
+// FeedItemTitleWithBreakdown is an item title template that appends the
+// week's status breakdown, e.g. "2026年 第5週 - Go Proposal 更新 (3 accepted, 1 declined)".
+const FeedItemTitleWithBreakdown = "{{.Year}}年 第{{.Week}}週 - Go Proposal 更新{{with .StatusBreakdown}} ({{.}}){{end}}"
+
+// WithFeedItemTitleTemplate sets a text/template for item titles, such as
+// FeedItemTitleWithBreakdown. The template is executed with a TitleData value
+// whose ProposalCount and StatusBreakdown describe the week.
+// An empty template keeps the default title.
+func WithFeedItemTitleTemplate(tmpl string) FeedOption {
+	return func(fg *FeedGenerator) {
+		fg.itemTitle = tmpl
+	}
+}
+
 // NewFeedGenerator creates a new FeedGenerator with the given options.
 func NewFeedGenerator(opts ...FeedOption) *FeedGenerator {
 	fg := &FeedGenerator{
@@ -65,15 +120,20 @@ func NewFeedGenerator(opts ...FeedOption) *FeedGenerator {
 		siteDesc:    "Go言語のproposal review meeting minutesの週次要約",
 		authorName:  "Go Proposal Digest",
 		authorEmail: "",
+		maxItems:    MaxFeedItems,
 	}
 	for _, opt := range opts {
 		opt(fg)
 	}
+	if fg.maxItems < 1 {
+		fg.maxItems = MaxFeedItems
+	}
 	return fg
 }
 
 // GenerateFeed generates an RSS 2.0 feed from the given weekly contents.
-// It limits the output to the most recent MaxFeedItems weeks.
+// It limits the output to the most recent weeks, MaxFeedItems unless set by
+// WithMaxItems.
 func (fg *FeedGenerator) GenerateFeed(ctx context.Context, weeks []*content.WeeklyContent) ([]byte, error) {
 	// Check for context cancellation
 	if err := ctx.Err(); err != nil {
@@ -96,65 +156,167 @@ func (fg *FeedGenerator) GenerateFeed(ctx context.Context, weeks []*content.Week
 	}
 
 	if weeks == nil || len(weeks) == 0 {
-		return fg.renderFeed(feed)
+		return fg.renderFeed(feed, nil)
 	}
 
-	// Sort weeks by date (newest first)
-	sortedWeeks := make([]*content.WeeklyContent, len(weeks))
-	copy(sortedWeeks, weeks)
-	sort.Slice(sortedWeeks, func(i, j int) bool {
-		if sortedWeeks[i].Year != sortedWeeks[j].Year {
-			return sortedWeeks[i].Year > sortedWeeks[j].Year
-		}
-		return sortedWeeks[i].Week > sortedWeeks[j].Week
-	})
-
-	// Limit to MaxFeedItems
-	limit := min(len(sortedWeeks), MaxFeedItems)
-
-	items := make([]*feedhub.Item, 0, limit)
-	for i := range limit {
-		week := sortedWeeks[i]
-		if week == nil {
-			continue
-		}
+	itemTitle, err := fg.parseItemTitle()
+	if err != nil {
+		return nil, err
+	}
 
+	latest := fg.latestWeeks(weeks)
+	items := make([]*feedhub.Item, 0, len(latest))
+	categories := make([][]string, 0, len(latest))
+	for _, week := range latest {
 		// Check for context cancellation
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
 
 		item := fg.weekToFeedItem(week)
+		if itemTitle != nil {
+			title, err := fg.renderItemTitle(itemTitle, week)
+			if err != nil {
+				return nil, err
+			}
+			item.Title = title
+		}
 		items = append(items, item)
+		categories = append(categories, weekStatuses(week))
 	}
 
 	feed.Items = items
 
-	return fg.renderFeed(feed)
+	return fg.renderFeed(feed, categories)
+}
+
+// GenerateFeedFiltered generates an RSS 2.0 feed like GenerateFeed, but each
+// item lists only the proposals whose current status is status, and weeks
+// without such proposals are left out. The feed is still valid RSS when no
+// proposal matches.
+func (fg *FeedGenerator) GenerateFeedFiltered(ctx context.Context, weeks []*content.WeeklyContent, status parser.Status) ([]byte, error) {
+	statusFG := *fg
+	statusFG.siteTitle = fmt.Sprintf("%s (%s)", fg.siteTitle, status)
+	return statusFG.GenerateFeed(ctx, filterWeeks(weeks, func(p content.ProposalContent) bool {
+		return p.CurrentStatus == status
+	}))
+}
+
+// GenerateCategoryFeed generates an RSS 2.0 feed like GenerateFeedFiltered,
+// listing only the proposals of category (see content.CategoryFromTitle).
+func (fg *FeedGenerator) GenerateCategoryFeed(ctx context.Context, weeks []*content.WeeklyContent, category string) ([]byte, error) {
+	categoryFG := *fg
+	categoryFG.siteTitle = fmt.Sprintf("%s (%s)", fg.siteTitle, category)
+	return categoryFG.GenerateFeed(ctx, filterWeeks(weeks, func(p content.ProposalContent) bool {
+		return proposalCategory(p) == category
+	}))
+}
+
+// filterWeeks returns copies of weeks holding only the proposals for which
+// keep returns true. Weeks left without proposals are dropped.
+func filterWeeks(weeks []*content.WeeklyContent, keep func(content.ProposalContent) bool) []*content.WeeklyContent {
+	filtered := make([]*content.WeeklyContent, 0, len(weeks))
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		var proposals []content.ProposalContent
+		for _, p := range week.Proposals {
+			if keep(p) {
+				proposals = append(proposals, p)
+			}
+		}
+		if len(proposals) == 0 {
+			continue
+		}
+		w := *week
+		w.Proposals = proposals
+		filtered = append(filtered, &w)
+	}
+	return filtered
+}
+
+// proposalCategory returns the category of p, deriving it from the title
+// when the content has none.
+func proposalCategory(p content.ProposalContent) string {
+	if p.Category != "" {
+		return p.Category
+	}
+	return content.CategoryFromTitle(p.Title)
+}
+
+// parseItemTitle parses the item title template.
+// It returns nil if no template is configured.
+func (fg *FeedGenerator) parseItemTitle() (*template.Template, error) {
+	if fg.itemTitle == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("feed item").Option("missingkey=error").Parse(fg.itemTitle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse feed item title template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// latestWeeks returns the most recent maxItems non-nil weeks, newest first.
+func (fg *FeedGenerator) latestWeeks(weeks []*content.WeeklyContent) []*content.WeeklyContent {
+	sorted := make([]*content.WeeklyContent, 0, len(weeks))
+	for _, week := range weeks {
+		if week != nil {
+			sorted = append(sorted, week)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Year != sorted[j].Year {
+			return sorted[i].Year > sorted[j].Year
+		}
+		return sorted[i].Week > sorted[j].Week
+	})
+	return sorted[:min(len(sorted), fg.maxItems)]
+}
+
+// weekDates returns the publication and update dates of a weekly item.
+// The publication date is the latest ChangedAt of the week's proposals, or
+// the week's CreatedAt; the update date also honors updated_at if enabled.
+func (fg *FeedGenerator) weekDates(week *content.WeeklyContent) (published, updated time.Time) {
+	published = week.CreatedAt
+	updated = week.CreatedAt
+	for _, p := range week.Proposals {
+		if p.ChangedAt.After(published) {
+			published = p.ChangedAt
+		}
+		mod := p.ChangedAt
+		if fg.useUpdated {
+			mod = p.LastModified()
+		}
+		if mod.After(updated) {
+			updated = mod
+		}
+	}
+	return published, updated
 }
 
 // weekToFeedItem converts a WeeklyContent to a feed item.
 func (fg *FeedGenerator) weekToFeedItem(week *content.WeeklyContent) *feedhub.Item {
 	title := fmt.Sprintf("%d年 第%d週 - Go Proposal 更新", week.Year, week.Week)
 	link := fmt.Sprintf("%s/%d/w%02d/", fg.siteURL, week.Year, week.Week)
+	// A week with a single proposal links straight to its review comment
+	if len(week.Proposals) == 1 && week.Proposals[0].CommentURL != "" {
+		link = week.Proposals[0].CommentURL
+	}
 	guid := fmt.Sprintf("%s/%d/w%02d", fg.siteURL, week.Year, week.Week)
 
 	description := fg.buildDescription(week)
 
 	// Use the latest proposal's changed time, or created time
-	pubDate := week.CreatedAt
-	for _, p := range week.Proposals {
-		if p.ChangedAt.After(pubDate) {
-			pubDate = p.ChangedAt
-		}
-	}
+	pubDate, updated := fg.weekDates(week)
 
 	item := &feedhub.Item{
 		Title:       title,
 		Link:        &feedhub.Link{Href: link},
 		Description: description,
 		Created:     pubDate,
-		Updated:     pubDate,
+		Updated:     updated,
 		Id:          guid,
 	}
 
@@ -166,6 +328,48 @@ func (fg *FeedGenerator) weekToFeedItem(week *content.WeeklyContent) *feedhub.It
 	return item
 }
 
+// renderItemTitle executes the item title template for a week.
+func (fg *FeedGenerator) renderItemTitle(tmpl *template.Template, week *content.WeeklyContent) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, TitleData{
+		SiteTitle:       fg.siteTitle,
+		Year:            week.Year,
+		Week:            week.Week,
+		ProposalCount:   len(week.Proposals),
+		StatusBreakdown: statusBreakdown(week.Proposals),
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute feed item title template: %w", err)
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+// statusBreakdown summarizes proposals by current status, such as
+// "3 accepted, 1 declined". Statuses are ordered by count (descending),
+// then by name. It returns an empty string if there are no proposals.
+func statusBreakdown(proposals []content.ProposalContent) string {
+	counts := make(map[parser.Status]int)
+	for _, p := range proposals {
+		counts[p.CurrentStatus]++
+	}
+
+	statuses := make([]parser.Status, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		if counts[statuses[i]] != counts[statuses[j]] {
+			return counts[statuses[i]] > counts[statuses[j]]
+		}
+		return statuses[i] < statuses[j]
+	})
+
+	parts := make([]string, len(statuses))
+	for i, status := range statuses {
+		parts[i] = fmt.Sprintf("%d %s", counts[status], status)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // buildDescription builds the description HTML for a weekly digest.
 func (fg *FeedGenerator) buildDescription(week *content.WeeklyContent) string {
 	var sb strings.Builder
@@ -177,11 +381,26 @@ func (fg *FeedGenerator) buildDescription(week *content.WeeklyContent) string {
 		return sb.String()
 	}
 
+	// Order proposals deterministically (by ChangedAt, then IssueNumber)
+	proposals := make([]content.ProposalContent, len(week.Proposals))
+	copy(proposals, week.Proposals)
+	content.SortProposals(proposals)
+
 	sb.WriteString("<ul>")
-	for _, p := range week.Proposals {
+	for _, p := range proposals {
 		sb.WriteString("<li>")
-		sb.WriteString(fmt.Sprintf("<strong>#%d</strong>: %s", p.IssueNumber, escapeHTML(p.Title)))
+		title := p.Title
+		if fg.stripPrefix {
+			title = content.StripProposalPrefix(title)
+		}
+		if fg.maxTitleLen > 0 {
+			title = truncateRunes(title, fg.maxTitleLen)
+		}
+		sb.WriteString(fmt.Sprintf("<strong>#%d</strong>: %s", p.IssueNumber, escapeHTML(title)))
 		sb.WriteString(fmt.Sprintf(" (<code>%s</code> → <code>%s</code>)", p.PreviousStatus, p.CurrentStatus))
+		if p.Reviewer != "" {
+			sb.WriteString(fmt.Sprintf(" 記録: @%s", escapeHTML(p.Reviewer)))
+		}
 		if p.Summary != "" {
 			sb.WriteString("<br/>")
 			// Truncate summary if too long (rune-aware to handle multibyte characters)
@@ -215,11 +434,61 @@ func truncateRunes(s string, maxRunes int) string {
 	return string(runes[:maxRunes-3]) + "..."
 }
 
-// renderFeed renders the feed to RSS 2.0 XML bytes.
-func (fg *FeedGenerator) renderFeed(feed *feedhub.Feed) ([]byte, error) {
-	rss, err := feed.ToRss()
+// rssXML is the RSS 2.0 document. It mirrors feedhub.RssFeedXml but uses
+// rssChannel so that items can carry several <category> elements, which
+// feedhub does not support.
+type rssXML struct {
+	XMLName          xml.Name `xml:"rss"`
+	Channel          *rssChannel
+	Version          string `xml:"version,attr"`
+	ContentNamespace string `xml:"xmlns:content,attr"`
+}
+
+// rssChannel is a feedhub channel whose items are replaced by rssItem.
+type rssChannel struct {
+	*feedhub.RssFeed
+	Items []*rssItem `xml:"item"`
+}
+
+// rssItem is a feedhub item with any number of <category> elements.
+type rssItem struct {
+	*feedhub.RssItem
+	Categories []string `xml:"category"`
+}
+
+// renderFeed renders the feed to RSS 2.0 XML bytes. categories[i] lists the
+// <category> values of feed.Items[i].
+func (fg *FeedGenerator) renderFeed(feed *feedhub.Feed, categories [][]string) ([]byte, error) {
+	channel := (&feedhub.Rss{Feed: feed}).RssFeed()
+	items := make([]*rssItem, len(channel.Items))
+	for i, item := range channel.Items {
+		items[i] = &rssItem{RssItem: item}
+		if i < len(categories) {
+			items[i].Categories = categories[i]
+		}
+	}
+	doc := rssXML{
+		Version:          "2.0",
+		ContentNamespace: "http://purl.org/rss/1.0/modules/content/",
+		Channel:          &rssChannel{RssFeed: channel, Items: items},
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate RSS: %w", err)
 	}
-	return []byte(rss), nil
+	// Match feedhub's output: the XML header without its trailing newline
+	return append([]byte(strings.TrimSuffix(xml.Header, "\n")), data...), nil
+}
+
+// weekStatuses returns the distinct current statuses of the week's
+// proposals in order of first appearance, used as item categories.
+func weekStatuses(week *content.WeeklyContent) []string {
+	var statuses []string
+	for _, p := range week.Proposals {
+		if status := string(p.CurrentStatus); status != "" && !slices.Contains(statuses, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
 }
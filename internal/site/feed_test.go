@@ -5,6 +5,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -31,11 +35,12 @@ type RSSChannel struct {
 
 // RSSItem represents an item in an RSS feed.
 type RSSItem struct {
-	Title       string `xml:"title"`
-	Link        string `xml:"link"`
-	Description string `xml:"description"`
-	PubDate     string `xml:"pubDate"`
-	GUID        string `xml:"guid"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	PubDate     string   `xml:"pubDate"`
+	GUID        string   `xml:"guid"`
+	Categories  []string `xml:"category"`
 }
 
 func TestFeedGenerator_GenerateFeed_EmptyContent(t *testing.T) {
@@ -401,6 +406,145 @@ func TestFeedGenerator_GenerateFeed_MaxItemsKeepsNewest(t *testing.T) {
 	}
 }
 
+func TestFeedGenerator_GenerateFeed_WithMaxItems(t *testing.T) {
+	t.Parallel()
+
+	// 30 weeks in ascending order
+	weeks := make([]*content.WeeklyContent, 0, 30)
+	for weekNum := 1; weekNum <= 30; weekNum++ {
+		weeks = append(weeks, &content.WeeklyContent{
+			Year: 2026,
+			Week: weekNum,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   10000 + weekNum,
+					Title:         "proposal: feature",
+					CurrentStatus: parser.StatusAccepted,
+					ChangedAt:     time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 7*weekNum),
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name      string
+		maxItems  int
+		wantItems int
+	}{
+		{name: "custom cap", maxItems: 25, wantItems: 25},
+		{name: "zero falls back to default", maxItems: 0, wantItems: MaxFeedItems},
+		{name: "negative falls back to default", maxItems: -1, wantItems: MaxFeedItems},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithSiteURL("https://example.com"), WithMaxItems(tt.maxItems))
+			data, err := fg.GenerateFeed(context.Background(), weeks)
+			if err != nil {
+				t.Fatalf("GenerateFeed() error = %v", err)
+			}
+
+			var rss RSS
+			if err := xml.Unmarshal(data, &rss); err != nil {
+				t.Fatalf("Failed to parse RSS: %v", err)
+			}
+			if len(rss.Channel.Items) != tt.wantItems {
+				t.Fatalf("got %d items, want %d", len(rss.Channel.Items), tt.wantItems)
+			}
+
+			// Newest first: week 30, 29, ...
+			for i, item := range rss.Channel.Items {
+				want := fmt.Sprintf("https://example.com/2026/w%02d/", 30-i)
+				if item.Link != want {
+					t.Errorf("item %d link = %q, want %q", i, item.Link, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFeedGenerator_GenerateFeed_ItemLinkAndCategories(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	proposal := func(issue int, status parser.Status, commentURL string) content.ProposalContent {
+		return content.ProposalContent{
+			IssueNumber:   issue,
+			Title:         fmt.Sprintf("proposal: %d", issue),
+			CurrentStatus: status,
+			ChangedAt:     changedAt,
+			CommentURL:    commentURL,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		proposals      []content.ProposalContent
+		wantLink       string
+		wantCategories []string
+	}{
+		{
+			name:           "single proposal links to its comment",
+			proposals:      []content.ProposalContent{proposal(1, parser.StatusAccepted, "https://github.com/golang/go/issues/33502#issuecomment-1")},
+			wantLink:       "https://github.com/golang/go/issues/33502#issuecomment-1",
+			wantCategories: []string{"accepted"},
+		},
+		{
+			name:           "single proposal without comment URL links to the week",
+			proposals:      []content.ProposalContent{proposal(1, parser.StatusActive, "")},
+			wantLink:       "https://example.com/2026/w05/",
+			wantCategories: []string{"active"},
+		},
+		{
+			name: "multiple proposals link to the week with distinct statuses",
+			proposals: []content.ProposalContent{
+				proposal(1, parser.StatusAccepted, "https://github.com/golang/go/issues/33502#issuecomment-1"),
+				proposal(2, parser.StatusDeclined, "https://github.com/golang/go/issues/33502#issuecomment-1"),
+				proposal(3, parser.StatusAccepted, "https://github.com/golang/go/issues/33502#issuecomment-1"),
+			},
+			wantLink:       "https://example.com/2026/w05/",
+			wantCategories: []string{"accepted", "declined"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithSiteURL("https://example.com"))
+			data, err := fg.GenerateFeed(context.Background(), []*content.WeeklyContent{
+				{Year: 2026, Week: 5, Proposals: tt.proposals},
+			})
+			if err != nil {
+				t.Fatalf("GenerateFeed() error = %v", err)
+			}
+
+			var rss RSS
+			if err := xml.Unmarshal(data, &rss); err != nil {
+				t.Fatalf("Failed to parse RSS: %v", err)
+			}
+			if rss.Version != "2.0" {
+				t.Errorf("version = %q, want 2.0", rss.Version)
+			}
+			if len(rss.Channel.Items) != 1 {
+				t.Fatalf("got %d items, want 1", len(rss.Channel.Items))
+			}
+			item := rss.Channel.Items[0]
+			if item.Link != tt.wantLink {
+				t.Errorf("link = %q, want %q", item.Link, tt.wantLink)
+			}
+			if item.GUID != "https://example.com/2026/w05" {
+				t.Errorf("guid = %q, want the weekly URL", item.GUID)
+			}
+			if !slices.Equal(item.Categories, tt.wantCategories) {
+				t.Errorf("categories = %v, want %v", item.Categories, tt.wantCategories)
+			}
+		})
+	}
+}
+
 func TestFeedGenerator_GenerateFeed_PubDateFormat(t *testing.T) {
 	fg := NewFeedGenerator(WithSiteURL("https://example.com"))
 
@@ -878,3 +1022,322 @@ func TestFeedGenerator_GenerateFeed_CrossYearWeeks(t *testing.T) {
 		t.Errorf("Second item should be from 2026, got: %s", rss.Channel.Items[1].Title)
 	}
 }
+
+func TestFeedGenerator_GenerateFeed_ItemTitleTemplate(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 1, Title: "proposal: a", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 2, Title: "proposal: b", CurrentStatus: parser.StatusDeclined, ChangedAt: changedAt},
+			{IssueNumber: 3, Title: "proposal: c", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 4, Title: "proposal: d", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "default title",
+			want: "2026年 第5週 - Go Proposal 更新",
+		},
+		{
+			name: "breakdown",
+			tmpl: FeedItemTitleWithBreakdown,
+			want: "2026年 第5週 - Go Proposal 更新 (3 accepted, 1 declined)",
+		},
+		{
+			name: "custom format",
+			tmpl: "W{{.Week}}: {{.ProposalCount}} proposals",
+			want: "W5: 4 proposals",
+		},
+		{
+			name:    "invalid template",
+			tmpl:    "{{.Week",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithFeedItemTitleTemplate(tt.tmpl))
+			data, err := fg.GenerateFeed(context.Background(), []*content.WeeklyContent{week})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GenerateFeed() error = %v", err)
+			}
+
+			var rss RSS
+			if err := xml.Unmarshal(data, &rss); err != nil {
+				t.Fatalf("Failed to parse RSS: %v", err)
+			}
+			if len(rss.Channel.Items) != 1 {
+				t.Fatalf("Expected 1 item, got %d", len(rss.Channel.Items))
+			}
+			if got := rss.Channel.Items[0].Title; got != tt.want {
+				t.Errorf("Item title = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeedGenerator_GenerateFeedFiltered(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: active only", PreviousStatus: parser.StatusDiscussions, CurrentStatus: parser.StatusActive, ChangedAt: changedAt.AddDate(0, 0, -7)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 2001, Title: "proposal: accepted one", PreviousStatus: parser.StatusLikelyAccept, CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+				{IssueNumber: 2002, Title: "proposal: declined one", PreviousStatus: parser.StatusLikelyDecline, CurrentStatus: parser.StatusDeclined, ChangedAt: changedAt},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		status       parser.Status
+		wantIssues   []string
+		unwantIssues []string
+		wantItems    int
+	}{
+		{
+			name:         "accepted lists only accepted proposals",
+			status:       parser.StatusAccepted,
+			wantItems:    1,
+			wantIssues:   []string{"#2001"},
+			unwantIssues: []string{"#1001", "#2002"},
+		},
+		{
+			name:         "declined lists only declined proposals",
+			status:       parser.StatusDeclined,
+			wantItems:    1,
+			wantIssues:   []string{"#2002"},
+			unwantIssues: []string{"#1001", "#2001"},
+		},
+		{
+			name:      "no matching proposals yields an empty valid feed",
+			status:    parser.StatusHold,
+			wantItems: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithSiteURL("https://example.com"))
+			data, err := fg.GenerateFeedFiltered(context.Background(), weeks, tt.status)
+			if err != nil {
+				t.Fatalf("GenerateFeedFiltered() error = %v", err)
+			}
+
+			var rss RSS
+			if err := xml.Unmarshal(data, &rss); err != nil {
+				t.Fatalf("Failed to parse RSS: %v", err)
+			}
+			if rss.Version != "2.0" {
+				t.Errorf("RSS version = %q, want %q", rss.Version, "2.0")
+			}
+			if !strings.Contains(rss.Channel.Title, string(tt.status)) {
+				t.Errorf("Channel title = %q, want it to mention %q", rss.Channel.Title, tt.status)
+			}
+			if len(rss.Channel.Items) != tt.wantItems {
+				t.Fatalf("Expected %d items, got %d", tt.wantItems, len(rss.Channel.Items))
+			}
+
+			var descriptions strings.Builder
+			for _, item := range rss.Channel.Items {
+				descriptions.WriteString(item.Description)
+			}
+			for _, issue := range tt.wantIssues {
+				if !strings.Contains(descriptions.String(), issue) {
+					t.Errorf("feed should contain %s", issue)
+				}
+			}
+			for _, issue := range tt.unwantIssues {
+				if strings.Contains(descriptions.String(), issue) {
+					t.Errorf("feed should not contain %s", issue)
+				}
+			}
+		})
+	}
+}
+
+func TestFeedGenerator_GenerateCategoryFeed(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: sync: add OnceValue", CurrentStatus: parser.StatusActive, ChangedAt: changedAt.AddDate(0, 0, -7)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 2001, Title: "proposal: net/http: add X", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+				{IssueNumber: 2002, Title: "proposal: sync/atomic: add Y", Category: "sync/atomic", CurrentStatus: parser.StatusDeclined, ChangedAt: changedAt},
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		category     string
+		wantIssues   []string
+		unwantIssues []string
+		wantItems    int
+	}{
+		{
+			name:         "sync lists only sync proposals",
+			category:     "sync",
+			wantItems:    1,
+			wantIssues:   []string{"#1001"},
+			unwantIssues: []string{"#2001", "#2002"},
+		},
+		{
+			name:         "nested package",
+			category:     "net/http",
+			wantItems:    1,
+			wantIssues:   []string{"#2001"},
+			unwantIssues: []string{"#1001", "#2002"},
+		},
+		{
+			name:      "no matching proposals yields an empty valid feed",
+			category:  "context",
+			wantItems: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithSiteURL("https://example.com"))
+			data, err := fg.GenerateCategoryFeed(context.Background(), weeks, tt.category)
+			if err != nil {
+				t.Fatalf("GenerateCategoryFeed() error = %v", err)
+			}
+
+			var rss RSS
+			if err := xml.Unmarshal(data, &rss); err != nil {
+				t.Fatalf("Failed to parse RSS: %v", err)
+			}
+			if !strings.Contains(rss.Channel.Title, tt.category) {
+				t.Errorf("Channel title = %q, want it to mention %q", rss.Channel.Title, tt.category)
+			}
+			if len(rss.Channel.Items) != tt.wantItems {
+				t.Fatalf("Expected %d items, got %d", tt.wantItems, len(rss.Channel.Items))
+			}
+
+			var descriptions strings.Builder
+			for _, item := range rss.Channel.Items {
+				descriptions.WriteString(item.Description)
+			}
+			for _, issue := range tt.wantIssues {
+				if !strings.Contains(descriptions.String(), issue) {
+					t.Errorf("feed should contain %s", issue)
+				}
+			}
+			for _, issue := range tt.unwantIssues {
+				if strings.Contains(descriptions.String(), issue) {
+					t.Errorf("feed should not contain %s", issue)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_StatusFeeds(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 2001, Title: "proposal: accepted one", PreviousStatus: parser.StatusLikelyAccept, CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		filename  string
+		wantItems int
+	}{
+		{filename: "feed-accepted.xml", wantItems: 1},
+		{filename: "feed-declined.xml", wantItems: 0},
+	} {
+		data, err := os.ReadFile(filepath.Join(distDir, tt.filename))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", tt.filename, err)
+		}
+		var rss RSS
+		if err := xml.Unmarshal(data, &rss); err != nil {
+			t.Fatalf("%s is not valid RSS: %v", tt.filename, err)
+		}
+		if len(rss.Channel.Items) != tt.wantItems {
+			t.Errorf("%s has %d items, want %d", tt.filename, len(rss.Channel.Items), tt.wantItems)
+		}
+	}
+}
+
+func TestFeedGenerator_UpdatedFromUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 3, 9, 30, 0, 0, time.UTC)
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 12345, Title: "proposal: edited", ChangedAt: changedAt, UpdatedAt: editedAt},
+		},
+	}
+
+	item := NewFeedGenerator(WithFeedUpdatedAt(true)).weekToFeedItem(week)
+	if !item.Updated.Equal(editedAt) {
+		t.Errorf("Updated = %v, want %v", item.Updated, editedAt)
+	}
+	if !item.Created.Equal(changedAt) {
+		t.Errorf("Created = %v, want %v", item.Created, changedAt)
+	}
+
+	item = NewFeedGenerator().weekToFeedItem(week)
+	if !item.Updated.Equal(changedAt) {
+		t.Errorf("Updated without option = %v, want %v", item.Updated, changedAt)
+	}
+}
@@ -2,15 +2,26 @@
 package site
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"html/template"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	"github.com/a-h/templ"
 	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
 	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
 )
 
@@ -23,10 +34,94 @@ const dirPerm = 0o755
 // filePerm is the permission mode for created files.
 const filePerm = 0o644
 
+// weeklyIndexFilename is the name of the weekly index page within a week directory.
+const weeklyIndexFilename = "index.html"
+
+// notFoundFilename is the name of the page static hosts serve for unknown URLs.
+const notFoundFilename = "404.html"
+
+// readingRunesPerMinute is the reading speed used to estimate how long a
+// summary takes to read, tuned for Japanese text.
+const readingRunesPerMinute = 400
+
+// reservedFilenames lists files written by Generate that proposal pages must
+// never overwrite.
+var reservedFilenames = map[string]bool{
+	weeklyIndexFilename: true,
+	"feed.xml":          true,
+	jsonFeedFilename:    true,
+	atomFeedFilename:    true,
+	statusFeedFilename(parser.StatusAccepted): true,
+	statusFeedFilename(parser.StatusDeclined): true,
+	changelogFilename:                         true,
+	dataExportFilename:                        true,
+	sitemapFilename:                           true,
+	opmlFilename:                              true,
+	humansFilename:                            true,
+	robotsFilename:                            true,
+	notFoundFilename:                          true,
+}
+
 // Generator handles static site generation from content data.
 type Generator struct {
-	distDir string
-	siteURL string
+	distDir          string
+	siteURL          string
+	subscribeSection bool
+	changelog        bool
+	dataExport       bool
+	provisional      bool
+	monthly          bool
+	categories       bool
+	useUpdatedAt     bool
+	statusContext    bool
+	maxInFlight      int
+	backLinkAnchors  bool
+	maxTitleLength   int
+	stripTitlePrefix bool
+	maxFeedItems     int
+	opml             bool
+	maxLinks         int
+	noIndexAggregate bool
+	noIndex          bool
+	minify           bool
+	hashedAssets     bool
+	recentDecision   time.Duration
+	alternates       []templates.AlternateLanguage
+	humansTxt        bool
+	yearReview       bool
+	weeklyLayout     templates.WeeklyLayout
+	maintainers      []string
+	now              func() time.Time
+	siteTitle        string
+	titleTemplates   titleTemplates
+	incremental      bool
+	templateDir      string
+
+	// overrides holds the override templates loaded from templateDir by
+	// Generate, keyed by filename.
+	overrides map[string]*template.Template
+
+	// assetNames maps stable asset names to the hashed names written by
+	// Generate when hashedAssets is set.
+	assetNames map[string]string
+
+	// pagesWritten and pagesSkipped count the HTML pages written and left
+	// unchanged by the last Generate call.
+	pagesWritten atomic.Int64
+	pagesSkipped atomic.Int64
+
+	// inFlightHook, if set, is called with +1 when a week starts rendering
+	// and -1 when it finishes. It is used by tests to observe concurrency.
+	inFlightHook func(delta int)
+}
+
+// titleTemplates holds the text/template sources for page titles.
+// An empty template keeps the page's built-in title.
+type titleTemplates struct {
+	home     string
+	weekly   string
+	proposal string
+	feedItem string
 }
 
 // Option is a functional option for configuring Generator.
@@ -46,11 +141,317 @@ func WithGeneratorSiteURL(url string) Option {
 	}
 }
 
+// WithSubscribeSection enables a "subscribe" section on the home page
+// listing the URLs of the generated feeds.
+func WithSubscribeSection(enabled bool) Option {
+	return func(g *Generator) {
+		g.subscribeSection = enabled
+	}
+}
+
+// WithChangelog enables generation of changelog.txt, a plain-text list of
+// every status transition in chronological order.
+func WithChangelog(enabled bool) Option {
+	return func(g *Generator) {
+		g.changelog = enabled
+	}
+}
+
+// WithDataExport enables generation of data.json, a JSON export of all
+// weeks with their proposals, statuses, summaries and links.
+func WithDataExport(enabled bool) Option {
+	return func(g *Generator) {
+		g.dataExport = enabled
+	}
+}
+
+// WithCategoryPages enables category/<name>/index.html pages listing the
+// proposals about each package, as derived from proposal titles, each with
+// an RSS feed named feed-<category>.xml that is also listed in the subscribe
+// section and feeds.opml.
+func WithCategoryPages(enabled bool) Option {
+	return func(g *Generator) {
+		g.categories = enabled
+	}
+}
+
+// WithProvisionalStatuses enables a de-emphasized badge style for statuses
+// that are not terminal, such as likely_accept and likely_decline; only
+// accepted, declined, retracted and removed keep the regular style.
+func WithProvisionalStatuses(enabled bool) Option {
+	return func(g *Generator) {
+		g.provisional = enabled
+	}
+}
+
+// WithMonthlyPages enables YYYY/MM/index.html rollup pages that aggregate
+// proposals by the month of their ChangedAt, linked from the home page.
+func WithMonthlyPages(enabled bool) Option {
+	return func(g *Generator) {
+		g.monthly = enabled
+	}
+}
+
+// WithUpdatedAtDates makes sitemap lastmod and feed item update dates prefer
+// a proposal's updated_at (last summary/link edit) over its changed_at.
+func WithUpdatedAtDates(enabled bool) Option {
+	return func(g *Generator) {
+		g.useUpdatedAt = enabled
+	}
+}
+
+// WithStatusContext enables a line on proposal pages describing when the
+// current status was set and since when the previous status had been in
+// effect, derived from earlier weeks.
+func WithStatusContext(enabled bool) Option {
+	return func(g *Generator) {
+		g.statusContext = enabled
+	}
+}
+
+// WithMaxInFlight sets the maximum number of weeks rendered concurrently by
+// Generate. Values less than 2 render weeks serially.
+func WithMaxInFlight(n int) Option {
+	return func(g *Generator) {
+		g.maxInFlight = n
+	}
+}
+
+// WithIncremental makes Generate skip rewriting weekly, proposal and
+// aggregate pages whose content hash matches the existing file, keeping
+// their modification times. The home page and feeds are always regenerated.
+func WithIncremental(enabled bool) Option {
+	return func(g *Generator) {
+		g.incremental = enabled
+	}
+}
+
+// WithTemplateDir makes Generate render the home, weekly and proposal pages
+// with the html/template files HomeTemplateFile, WeeklyTemplateFile and
+// ProposalTemplateFile found in dir. Pages without an override file use the
+// built-in templates.
+func WithTemplateDir(dir string) Option {
+	return func(g *Generator) {
+		g.templateDir = dir
+	}
+}
+
+// WithBackLinkAnchors makes the "back to week" link on proposal pages return
+// to the proposal's position (#p-NNNN) on the weekly index.
+func WithBackLinkAnchors(enabled bool) Option {
+	return func(g *Generator) {
+		g.backLinkAnchors = enabled
+	}
+}
+
+// WithMaxTitleLength truncates proposal titles longer than n runes in page
+// headings and feed descriptions, appending "...". The full title is kept in
+// <title> and og:title. Values less than 1 disable truncation.
+func WithMaxTitleLength(n int) Option {
+	return func(g *Generator) {
+		g.maxTitleLength = n
+	}
+}
+
+// WithStripTitlePrefix removes the redundant "proposal:" prefix from
+// proposal titles in page headings, <title>, og:title and feed item
+// descriptions (see content.StripProposalPrefix). The stored titles are
+// unchanged.
+func WithStripTitlePrefix(enabled bool) Option {
+	return func(g *Generator) {
+		g.stripTitlePrefix = enabled
+	}
+}
+
+// WithMaxFeedItems sets the maximum number of weekly items in the RSS and
+// JSON feeds. Values less than 1 use MaxFeedItems.
+func WithMaxFeedItems(n int) Option {
+	return func(g *Generator) {
+		g.maxFeedItems = n
+	}
+}
+
+// WithAlternateLanguage declares a language version of the site at baseURL
+// (e.g. "en", "https://example.com/en"). Every page links the equivalent
+// page of each declared version with <link rel="alternate" hreflang>.
+// Declare the site's own language as well so that the links are reciprocal.
+// It may be given multiple times.
+func WithAlternateLanguage(lang, baseURL string) Option {
+	return func(g *Generator) {
+		g.alternates = append(g.alternates, templates.AlternateLanguage{
+			Lang:    lang,
+			BaseURL: strings.TrimSuffix(baseURL, "/"),
+		})
+	}
+}
+
+// WithRecentDecisionWindow highlights proposals that reached a terminal
+// status (see parser.Status.IsTerminal) within d before the generation time with a
+// "new decision" ribbon on weekly indexes and a count on the home page.
+// Zero (the default) disables the ribbon.
+func WithRecentDecisionWindow(d time.Duration) Option {
+	return func(g *Generator) {
+		g.recentDecision = d
+	}
+}
+
+// WithClock sets the function returning the generation time, which is
+// compared against ChangedAt for recent decisions. Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(g *Generator) {
+		g.now = now
+	}
+}
+
+// WithNoIndexAggregates marks aggregate pages that repeat content found on
+// weekly and proposal pages (the monthly, category, year in review and
+// statistics pages) with <meta name="robots" content="noindex,follow"> and
+// leaves them out of sitemap.xml. Weekly and proposal pages stay indexable.
+func WithNoIndexAggregates(enabled bool) Option {
+	return func(g *Generator) {
+		g.noIndexAggregate = enabled
+	}
+}
+
+// WithNoIndex asks search engines not to index the site, e.g. for preview
+// deployments: robots.txt disallows all crawlers and every page gets
+// <meta name="robots" content="noindex">. By default the site is indexable
+// and robots.txt points at sitemap.xml.
+func WithNoIndex(enabled bool) Option {
+	return func(g *Generator) {
+		g.noIndex = enabled
+	}
+}
+
+// WithMinify strips HTML comments and collapses insignificant whitespace in
+// every generated page. The contents of <pre>, <code>, <textarea>, <script>
+// and <style> are kept as they are. Defaults to false so that the output
+// stays readable during development.
+func WithMinify(enabled bool) Option {
+	return func(g *Generator) {
+		g.minify = enabled
+	}
+}
+
+// WithHashedAssets copies styles.css and components.js in the dist
+// directory to names containing a short content hash (e.g.
+// styles.0123abcd.css) and references those names from every page, so that
+// caches never serve stale assets after a deploy. The assets must be built
+// into the dist directory before Generate runs; missing assets keep their
+// stable names.
+func WithHashedAssets(enabled bool) Option {
+	return func(g *Generator) {
+		g.hashedAssets = enabled
+	}
+}
+
+// WithMaxLinks caps the number of related links shown on proposal pages.
+// Links beyond the cap are collapsed behind a "show more" toggle, preferring
+// the proposal issue and design documents for the visible slots. All links
+// are kept in the content files. Zero (the default) shows every link.
+func WithMaxLinks(n int) Option {
+	return func(g *Generator) {
+		g.maxLinks = n
+	}
+}
+
+// WithOPML enables generation of feeds.opml, an OPML subscription list of
+// every generated feed.
+func WithOPML(enabled bool) Option {
+	return func(g *Generator) {
+		g.opml = enabled
+	}
+}
+
+// WithHumansTxt enables generation of humans.txt crediting the given
+// maintainers (e.g. "Jane Doe <https://github.com/janedoe>"), the proposal
+// review data source and this tool. Every page links it with rel="author".
+func WithHumansTxt(maintainers ...string) Option {
+	return func(g *Generator) {
+		g.humansTxt = true
+		g.maintainers = maintainers
+	}
+}
+
+// WeeklyLayout selects how proposals are presented on weekly index pages.
+type WeeklyLayout = templates.WeeklyLayout
+
+// Weekly index layouts.
+const (
+	// WeeklyLayoutCards renders each proposal as a card (the default).
+	WeeklyLayoutCards = templates.WeeklyLayoutCards
+	// WeeklyLayoutTable renders proposals as an accessible data table with a
+	// caption and scoped headers, for screen-reader and keyboard users.
+	WeeklyLayoutTable = templates.WeeklyLayoutTable
+)
+
+// WithWeeklyLayout sets the presentation of weekly index pages.
+func WithWeeklyLayout(layout WeeklyLayout) Option {
+	return func(g *Generator) {
+		g.weeklyLayout = layout
+	}
+}
+
+// WithYearInReview enables generation of a year in review page
+// (YYYY/review.html) for each year, summarizing totals, the acceptance rate,
+// the busiest weeks and the accepted proposals.
+func WithYearInReview(enabled bool) Option {
+	return func(g *Generator) {
+		g.yearReview = enabled
+	}
+}
+
+// WithGeneratorSiteTitle sets the site title exposed to page title templates
+// as {{.SiteTitle}}.
+func WithGeneratorSiteTitle(title string) Option {
+	return func(g *Generator) {
+		g.siteTitle = title
+	}
+}
+
+// WithHomeTitleTemplate sets a text/template for the home page title.
+// The template is executed with a TitleData value.
+func WithHomeTitleTemplate(tmpl string) Option {
+	return func(g *Generator) {
+		g.titleTemplates.home = tmpl
+	}
+}
+
+// WithWeeklyTitleTemplate sets a text/template for weekly index page titles,
+// e.g. "Week {{.Week}}, {{.Year}} — {{.SiteTitle}}".
+// The template is executed with a TitleData value.
+func WithWeeklyTitleTemplate(tmpl string) Option {
+	return func(g *Generator) {
+		g.titleTemplates.weekly = tmpl
+	}
+}
+
+// WithGeneratorFeedItemTitleTemplate sets a text/template for RSS item
+// titles, such as FeedItemTitleWithBreakdown.
+// The template is executed with a TitleData value.
+func WithGeneratorFeedItemTitleTemplate(tmpl string) Option {
+	return func(g *Generator) {
+		g.titleTemplates.feedItem = tmpl
+	}
+}
+
+// WithProposalTitleTemplate sets a text/template for proposal page titles,
+// e.g. "#{{.IssueNumber}} {{.Title}} — {{.SiteTitle}}".
+// The template is executed with a TitleData value.
+func WithProposalTitleTemplate(tmpl string) Option {
+	return func(g *Generator) {
+		g.titleTemplates.proposal = tmpl
+	}
+}
+
 // NewGenerator creates a new site Generator with the given options.
 func NewGenerator(opts ...Option) *Generator {
 	g := &Generator{
-		distDir: "dist",
-		siteURL: "https://example.com",
+		distDir:     "dist",
+		siteURL:     "https://example.com",
+		siteTitle:   templates.DefaultSiteName,
+		maxInFlight: 1,
+		now:         time.Now,
 	}
 	for _, opt := range opts {
 		opt(g)
@@ -63,14 +464,45 @@ func NewGenerator(opts ...Option) *Generator {
 // - index.html (home page with week listing)
 // - YYYY/wWW/index.html (weekly index pages)
 // - YYYY/wWW/NNNNN.html (individual proposal pages)
+// - archive/index.html (all weeks grouped by year)
+// - stats/index.html (status counts in total and per week)
+// - 404.html (page served by static hosts for unknown URLs)
+// - YYYY/MM/index.html (monthly rollup pages, if enabled)
+// - category/<name>/index.html (proposals per package, if enabled)
+// - feed-<category>.xml (RSS 2.0 feeds per category, with category pages)
+// - YYYY/review.html (year in review pages, if enabled)
 // - feed.xml (RSS 2.0 feed)
+// - feed.json (JSON Feed 1.1)
+// - atom.xml (Atom 1.0 feed)
+// - feed-<status>.xml (RSS 2.0 feeds per terminal status, e.g. feed-accepted.xml)
+// - sitemap.xml (sitemap of home, weekly, proposal and indexable aggregate pages)
+// - changelog.txt (plain-text transition list, if enabled)
+// - data.json (JSON export of all content, if enabled)
+// - feeds.opml (OPML list of the generated feeds, if enabled)
+// - humans.txt (credits for maintainers and the data source, if enabled)
+// - robots.txt (allows all crawlers, or disallows all with WithNoIndex)
 // - Static files copied from web/public/ to dist/
+// - styles.<hash>.css and components.<hash>.js (hashed asset copies, if enabled)
 func (g *Generator) Generate(ctx context.Context, weeks []*content.WeeklyContent) error {
 	// Check for context cancellation at the start
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 
+	// Refuse to write anything if proposal pages of any week would overwrite
+	// each other
+	if err := validateWeeks(weeks); err != nil {
+		return err
+	}
+
+	g.pagesWritten.Store(0)
+	g.pagesSkipped.Store(0)
+
+	// Load override templates before writing anything
+	if err := g.loadOverrides(); err != nil {
+		return err
+	}
+
 	// Create the dist directory
 	if err := os.MkdirAll(g.distDir, dirPerm); err != nil {
 		return fmt.Errorf("failed to create dist directory: %w", err)
@@ -81,6 +513,16 @@ func (g *Generator) Generate(ctx context.Context, weeks []*content.WeeklyContent
 		return fmt.Errorf("failed to copy static files: %w", err)
 	}
 
+	// Write content-hashed copies of the frontend assets
+	g.assetNames = nil
+	if g.hashedAssets {
+		names, err := g.writeHashedAssets(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to write hashed assets: %w", err)
+		}
+		g.assetNames = names
+	}
+
 	// Convert weeks to template data
 	var weeklyDataList []templates.WeeklyData
 	for _, week := range weeks {
@@ -97,70 +539,482 @@ func (g *Generator) Generate(ctx context.Context, weeks []*content.WeeklyContent
 		return weeklyDataList[i].Week > weeklyDataList[j].Week
 	})
 
+	// Group proposals by month for rollup pages
+	var months []templates.MonthlyData
+	if g.monthly {
+		months = templates.ConvertToMonthlyData(weeks)
+	}
+
+	// Group proposals by package for category pages and feeds
+	var categories []templates.CategoryData
+	if g.categories {
+		categories = templates.ConvertToCategoryData(weeks)
+	}
+	feeds := g.feedLinks(categories)
+
 	// Generate home page
-	if err := g.generateHomePage(ctx, weeklyDataList); err != nil {
+	if err := g.generateHomePage(ctx, weeklyDataList, months, feeds); err != nil {
 		return fmt.Errorf("failed to generate home page: %w", err)
 	}
 
-	// Generate weekly pages and proposal pages
-	for _, week := range weeks {
-		if week == nil {
-			continue
+	// Generate archive page
+	if err := g.generateArchivePage(ctx, weeklyDataList); err != nil {
+		return fmt.Errorf("failed to generate archive page: %w", err)
+	}
+
+	// Generate statistics page
+	if err := g.generateStatsPage(ctx, weeks); err != nil {
+		return fmt.Errorf("failed to generate stats page: %w", err)
+	}
+
+	// Generate 404 page
+	if err := g.generateNotFoundPage(ctx, weeklyDataList); err != nil {
+		return fmt.Errorf("failed to generate 404 page: %w", err)
+	}
+
+	// Generate monthly rollup pages
+	for _, month := range months {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		if err := g.generateMonthlyPage(ctx, month); err != nil {
+			return fmt.Errorf("failed to generate monthly page for %d-%02d: %w",
+				month.Year, month.Month, err)
+		}
+	}
 
-		// Check for context cancellation
+	// Generate category pages
+	for _, category := range categories {
 		if err := ctx.Err(); err != nil {
 			return err
 		}
+		if err := g.generateCategoryPage(ctx, category); err != nil {
+			return fmt.Errorf("failed to generate category page for %s: %w", category.Name, err)
+		}
+		if err := g.generateCategoryFeed(ctx, weeks, category.Name); err != nil {
+			return fmt.Errorf("failed to generate category feed for %s: %w", category.Name, err)
+		}
+	}
+
+	// Generate year in review pages
+	var reviews []templates.YearReviewData
+	if g.yearReview {
+		reviews = templates.ConvertToYearReviews(weeks)
+	}
+	for _, review := range reviews {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := g.generateYearReviewPage(ctx, review); err != nil {
+			return fmt.Errorf("failed to generate year in review page for %d: %w", review.Year, err)
+		}
+	}
+
+	// Index every proposal entry by issue number for status context
+	var history map[int][]content.ProposalContent
+	if g.statusContext {
+		history = buildStatusHistory(weeks)
+	}
+
+	// Generate weekly pages and proposal pages
+	if err := g.generateWeeks(ctx, weeks, history, buildWeekNeighbors(weeks)); err != nil {
+		return err
+	}
+
+	// Generate RSS feed
+	if err := g.generateRSSFeed(ctx, weeks); err != nil {
+		return fmt.Errorf("failed to generate RSS feed: %w", err)
+	}
+
+	// Generate per-status RSS feeds
+	if err := g.generateStatusFeeds(ctx, weeks); err != nil {
+		return fmt.Errorf("failed to generate status feeds: %w", err)
+	}
+
+	// Generate JSON Feed
+	if err := g.generateJSONFeed(ctx, weeks); err != nil {
+		return fmt.Errorf("failed to generate JSON feed: %w", err)
+	}
+
+	// Generate Atom feed
+	if err := g.generateAtomFeed(ctx, weeks); err != nil {
+		return fmt.Errorf("failed to generate Atom feed: %w", err)
+	}
+
+	// Generate sitemap
+	if err := g.generateSitemap(ctx, weeks, months, reviews, categories); err != nil {
+		return fmt.Errorf("failed to generate sitemap: %w", err)
+	}
+
+	// Generate plain-text changelog
+	if g.changelog {
+		if err := g.generateChangelog(ctx, weeks); err != nil {
+			return fmt.Errorf("failed to generate changelog: %w", err)
+		}
+	}
+
+	// Generate JSON export of all content
+	if g.dataExport {
+		if err := g.generateDataExport(ctx, weeks); err != nil {
+			return fmt.Errorf("failed to generate data export: %w", err)
+		}
+	}
+
+	// Generate OPML subscription list
+	if g.opml {
+		if err := g.generateOPML(ctx, feeds); err != nil {
+			return fmt.Errorf("failed to generate OPML: %w", err)
+		}
+	}
+
+	// Generate humans.txt credits
+	if g.humansTxt {
+		if err := g.generateHumansTxt(ctx); err != nil {
+			return fmt.Errorf("failed to generate humans.txt: %w", err)
+		}
+	}
+
+	// Generate robots.txt
+	if err := g.generateRobotsTxt(ctx); err != nil {
+		return fmt.Errorf("failed to generate robots.txt: %w", err)
+	}
+
+	return nil
+}
+
+// GenerateWeek regenerates only the weekly index page and proposal pages of
+// week, which is much faster than Generate for previews while editing.
+// allWeeks is the full list of weeks as passed to Generate; it provides the
+// previous/next week navigation and status context. Pages of other weeks,
+// aggregate pages, feeds and static files are not written, so a full
+// Generate is still needed before publishing.
+func (g *Generator) GenerateWeek(ctx context.Context, week *content.WeeklyContent, allWeeks []*content.WeeklyContent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if week == nil {
+		return errors.New("week is required")
+	}
+	if err := validateWeeks([]*content.WeeklyContent{week}); err != nil {
+		return err
+	}
+
+	g.pagesWritten.Store(0)
+	g.pagesSkipped.Store(0)
+
+	if err := g.loadOverrides(); err != nil {
+		return err
+	}
+
+	// Reference the hashed asset copies written by the last full build
+	g.assetNames = nil
+	if g.hashedAssets {
+		names, err := g.writeHashedAssets(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to write hashed assets: %w", err)
+		}
+		g.assetNames = names
+	}
+
+	var history map[int][]content.ProposalContent
+	if g.statusContext {
+		history = buildStatusHistory(allWeeks)
+	}
+
+	// Include week in the navigation even if allWeeks does not contain it
+	navWeeks := append(slices.Clone(allWeeks), week)
+	return g.generateWeek(ctx, week, history, buildWeekNeighbors(navWeeks))
+}
 
-		weeklyData := templates.ConvertToWeeklyData(week)
+// loadOverrides loads the override templates of WithTemplateDir, if any.
+func (g *Generator) loadOverrides() error {
+	g.overrides = nil
+	if g.templateDir == "" {
+		return nil
+	}
+	overrides, err := loadOverrideTemplates(g.templateDir)
+	if err != nil {
+		return fmt.Errorf("failed to load override templates: %w", err)
+	}
+	g.overrides = overrides
+	return nil
+}
+
+// weekNeighbors holds the chronologically adjacent weeks of a week.
+type weekNeighbors struct {
+	prev *templates.WeekLink
+	next *templates.WeekLink
+}
 
-		// Generate weekly index page
-		if err := g.generateWeeklyIndexPage(ctx, weeklyData); err != nil {
-			return fmt.Errorf("failed to generate weekly index page for %d-W%02d: %w",
-				week.Year, week.Week, err)
+// buildWeekNeighbors maps each week to its previous (older) and next (newer)
+// week in chronological order. Nil and duplicate weeks are ignored.
+func buildWeekNeighbors(weeks []*content.WeeklyContent) map[templates.WeekLink]weekNeighbors {
+	links := make([]templates.WeekLink, 0, len(weeks))
+	seen := make(map[templates.WeekLink]bool, len(weeks))
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		link := templates.WeekLink{Year: week.Year, Week: week.Week}
+		if seen[link] {
+			continue
+		}
+		seen[link] = true
+		links = append(links, link)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].Year != links[j].Year {
+			return links[i].Year < links[j].Year
 		}
+		return links[i].Week < links[j].Week
+	})
 
-		// Generate individual proposal pages
-		for _, proposal := range week.Proposals {
-			if err := ctx.Err(); err != nil {
+	neighbors := make(map[templates.WeekLink]weekNeighbors, len(links))
+	for i, link := range links {
+		var n weekNeighbors
+		if i > 0 {
+			n.prev = &links[i-1]
+		}
+		if i < len(links)-1 {
+			n.next = &links[i+1]
+		}
+		neighbors[link] = n
+	}
+	return neighbors
+}
+
+// generateWeeks generates the weekly index and proposal pages of each week,
+// rendering at most maxInFlight weeks concurrently. Rendered pages are written
+// straight to disk and not retained. The first failure cancels the remaining
+// weeks; if several weeks fail, the error of the first one in input order is
+// returned, ignoring cancellations caused by that failure.
+func (g *Generator) generateWeeks(ctx context.Context, weeks []*content.WeeklyContent, history map[int][]content.ProposalContent, neighbors map[templates.WeekLink]weekNeighbors) error {
+	if g.maxInFlight < 2 {
+		for _, week := range weeks {
+			if week == nil {
+				continue
+			}
+			if err := g.generateWeek(ctx, week, history, neighbors); err != nil {
 				return err
 			}
+		}
+		return nil
+	}
 
-			detailData := templates.ConvertToProposalDetailData(week, proposal.IssueNumber)
-			if detailData == nil {
-				return fmt.Errorf("failed to convert proposal data for #%d: proposal not found in week data",
-					proposal.IssueNumber)
-			}
-			if err := g.generateProposalPage(ctx, *detailData); err != nil {
-				return fmt.Errorf("failed to generate proposal page for #%d: %w",
-					proposal.IssueNumber, err)
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make([]error, len(weeks))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, g.maxInFlight)
+	for i, week := range weeks {
+		if week == nil {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := g.generateWeek(ctx, week, history, neighbors); err != nil {
+				errs[i] = err
+				cancel()
 			}
+		}()
+	}
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return err
+	}
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return err
 		}
 	}
+	return nil
+}
 
-	// Generate RSS feed
-	if err := g.generateRSSFeed(ctx, weeks); err != nil {
-		return fmt.Errorf("failed to generate RSS feed: %w", err)
+// generateWeek generates the weekly index page and proposal pages of a week.
+func (g *Generator) generateWeek(ctx context.Context, week *content.WeeklyContent, history map[int][]content.ProposalContent, neighbors map[templates.WeekLink]weekNeighbors) error {
+	if g.inFlightHook != nil {
+		g.inFlightHook(1)
+		defer g.inFlightHook(-1)
+	}
+
+	// Check for context cancellation
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	weeklyData := templates.ConvertToWeeklyData(week)
+	adjacent := neighbors[templates.WeekLink{Year: week.Year, Week: week.Week}]
+	weeklyData.PrevWeek = adjacent.prev
+	weeklyData.NextWeek = adjacent.next
+
+	// Generate weekly index page
+	if err := g.generateWeeklyIndexPage(ctx, weeklyData); err != nil {
+		return fmt.Errorf("failed to generate weekly index page for %d-W%02d: %w",
+			week.Year, week.Week, err)
+	}
+
+	// Generate individual proposal pages
+	for _, proposal := range week.Proposals {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		detailData := templates.ConvertToProposalDetailData(week, proposal.IssueNumber)
+		if detailData == nil {
+			return fmt.Errorf("failed to convert proposal data for #%d: proposal not found in week data",
+				proposal.IssueNumber)
+		}
+		if g.statusContext {
+			detailData.ShowStatusContext = true
+			detailData.PreviousStatusSince = previousStatusSince(history[proposal.IssueNumber], proposal)
+		}
+		if err := g.generateProposalPage(ctx, *detailData); err != nil {
+			return fmt.Errorf("failed to generate proposal page for #%d: %w",
+				proposal.IssueNumber, err)
+		}
 	}
 
 	return nil
 }
 
 // generateHomePage generates the home page (index.html).
-func (g *Generator) generateHomePage(ctx context.Context, weeks []templates.WeeklyData) error {
+func (g *Generator) generateHomePage(ctx context.Context, weeks []templates.WeeklyData, months []templates.MonthlyData, feeds []templates.FeedLink) error {
 	homeData := templates.ConvertToHomeData(weeks, g.siteURL)
-	component := templates.HomePage(homeData)
+	homeData.Months = templates.ConvertToMonthSummaries(months)
+	homeData.Alternates = g.alternates
+	homeData.AuthorURL = g.authorURL()
+	for i, week := range weeks {
+		for _, p := range week.Proposals {
+			if g.isRecentDecision(p.CurrentStatus, p.ChangedAt) {
+				homeData.Weeks[i].RecentDecisions++
+			}
+		}
+	}
+	if g.subscribeSection {
+		homeData.Feeds = feeds
+	}
+	title, err := g.renderTitle("home", g.titleTemplates.home, TitleData{})
+	if err != nil {
+		return err
+	}
+	homeData.PageTitle = title
+	component := g.pageComponent(HomeTemplateFile, homeData, templates.HomePage(homeData))
 
 	filePath := filepath.Join(g.distDir, "index.html")
 	return g.renderToFile(ctx, filePath, component)
 }
 
+// generateArchivePage generates the archive page (archive/index.html).
+// Like the home page, it depends on every week and is always regenerated.
+func (g *Generator) generateArchivePage(ctx context.Context, weeks []templates.WeeklyData) error {
+	data := templates.ConvertToArchiveData(weeks, g.siteURL)
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	component := templates.ArchivePage(data)
+
+	dirPath := filepath.Join(g.distDir, "archive")
+	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	filePath := filepath.Join(dirPath, "index.html")
+	return g.renderToFile(ctx, filePath, component)
+}
+
+// generateNotFoundPage generates the 404 page (404.html), linking to the
+// newest of weeks (sorted newest first) when there is one.
+func (g *Generator) generateNotFoundPage(ctx context.Context, weeks []templates.WeeklyData) error {
+	data := templates.NotFoundData{
+		SiteURL:    g.siteURL,
+		Alternates: g.alternates,
+		AuthorURL:  g.authorURL(),
+	}
+	if len(weeks) > 0 {
+		data.LatestWeek = &templates.WeekLink{Year: weeks[0].Year, Week: weeks[0].Week}
+	}
+
+	filePath := filepath.Join(g.distDir, notFoundFilename)
+	return g.renderToFile(ctx, filePath, templates.NotFoundPage(data))
+}
+
+// generateStatsPage generates the statistics page (stats/index.html).
+// Like the home page, it depends on every week and is always regenerated.
+func (g *Generator) generateStatsPage(ctx context.Context, weeks []*content.WeeklyContent) error {
+	data := templates.ConvertToStatsData(weeks, g.siteURL)
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.NoIndex = g.noIndexAggregate
+	component := templates.StatsPage(data)
+
+	dirPath := filepath.Join(g.distDir, "stats")
+	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
+		return fmt.Errorf("failed to create stats directory: %w", err)
+	}
+
+	filePath := filepath.Join(dirPath, "index.html")
+	return g.renderToFile(ctx, filePath, component)
+}
+
+// feedLinks returns the feeds produced by Generate with absolute URLs,
+// including the feeds of categories. It is the single list used by the
+// subscribe section and feeds.opml.
+func (g *Generator) feedLinks(categories []templates.CategoryData) []templates.FeedLink {
+	feeds := []templates.FeedLink{
+		{Title: "RSS", URL: g.siteURL + templates.DefaultFeedURL, Type: "application/rss+xml"},
+		{Title: "JSON Feed", URL: g.siteURL + templates.DefaultJSONFeedURL, Type: "application/feed+json"},
+		{Title: "Atom", URL: g.siteURL + templates.DefaultAtomFeedURL, Type: "application/atom+xml"},
+		{Title: "RSS (accepted)", URL: g.siteURL + "/" + statusFeedFilename(parser.StatusAccepted), Type: "application/rss+xml"},
+		{Title: "RSS (declined)", URL: g.siteURL + "/" + statusFeedFilename(parser.StatusDeclined), Type: "application/rss+xml"},
+	}
+	for _, category := range categories {
+		feeds = append(feeds, templates.FeedLink{
+			Title: "RSS (" + category.Name + ")",
+			URL:   g.siteURL + "/" + categoryFeedFilename(category.Name),
+			Type:  "application/rss+xml",
+		})
+	}
+	return feeds
+}
+
+// weekBreadcrumbs returns the breadcrumb trail of a weekly index page:
+// home, the year's section of the archive and the week.
+func weekBreadcrumbs(year, week int) []templates.Breadcrumb {
+	return []templates.Breadcrumb{
+		{Label: "ホーム", URL: "/"},
+		{Label: fmt.Sprintf("%d", year), URL: fmt.Sprintf("%s#y%d", templates.ArchiveURL, year)},
+		{Label: fmt.Sprintf("第%d週", week), URL: templates.WeekLink{Year: year, Week: week}.URL()},
+	}
+}
+
 // generateWeeklyIndexPage generates a weekly index page.
 func (g *Generator) generateWeeklyIndexPage(ctx context.Context, data templates.WeeklyData) error {
 	// Set the site URL for OGP tags
 	data.SiteURL = g.siteURL
-	component := templates.WeeklyIndexPage(data)
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.Layout = g.weeklyLayout
+	data.Breadcrumbs = weekBreadcrumbs(data.Year, data.Week)
+	g.decorateProposals(data.Proposals)
+	title, err := g.renderTitle("weekly", g.titleTemplates.weekly, TitleData{
+		Year: data.Year,
+		Week: data.Week,
+	})
+	if err != nil {
+		return err
+	}
+	data.PageTitle = title
+	component := g.pageComponent(WeeklyTemplateFile, data, templates.WeeklyIndexPage(data))
 
 	// Create directory path: dist/YYYY/wWW/
 	dirPath := filepath.Join(g.distDir, fmt.Sprintf("%d", data.Year), fmt.Sprintf("w%02d", data.Week))
@@ -168,15 +1022,226 @@ func (g *Generator) generateWeeklyIndexPage(ctx context.Context, data templates.
 		return fmt.Errorf("failed to create weekly directory: %w", err)
 	}
 
+	filePath := filepath.Join(dirPath, weeklyIndexFilename)
+	return g.renderPage(ctx, filePath, component)
+}
+
+// decorateProposals applies display options to proposals on list pages.
+func (g *Generator) decorateProposals(proposals []templates.ProposalData) {
+	for i := range proposals {
+		if g.provisional {
+			proposals[i].Provisional = !proposals[i].CurrentStatus.IsTerminal()
+		}
+		proposals[i].Title = g.proposalTitle(proposals[i].Title)
+		proposals[i].DisplayTitle = g.displayTitle(proposals[i].Title)
+		proposals[i].RecentDecision = g.isRecentDecision(proposals[i].CurrentStatus, proposals[i].ChangedAt)
+	}
+}
+
+// isRecentDecision reports whether a proposal reached a terminal status
+// within the recent decision window before the generation time.
+func (g *Generator) isRecentDecision(status parser.Status, changedAt time.Time) bool {
+	if g.recentDecision <= 0 || !status.IsTerminal() {
+		return false
+	}
+	age := g.now().Sub(changedAt)
+	return age >= 0 && age <= g.recentDecision
+}
+
+// summaryStats returns the length of summary in runes and the estimated
+// minutes to read it at readingRunesPerMinute, rounded up.
+func summaryStats(summary string) (runes, minutes int) {
+	runes = utf8.RuneCountInString(summary)
+	return runes, (runes + readingRunesPerMinute - 1) / readingRunesPerMinute
+}
+
+// proposalTitle returns title as shown on pages, without the "proposal:"
+// prefix if WithStripTitlePrefix is enabled.
+func (g *Generator) proposalTitle(title string) string {
+	if !g.stripTitlePrefix {
+		return title
+	}
+	return content.StripProposalPrefix(title)
+}
+
+// displayTitle returns title truncated to maxTitleLength runes, or an empty
+// string if no truncation is needed so that templates show the full title.
+func (g *Generator) displayTitle(title string) string {
+	if g.maxTitleLength < 1 {
+		return ""
+	}
+	if short := truncateRunes(title, g.maxTitleLength); short != title {
+		return short
+	}
+	return ""
+}
+
+// generateMonthlyPage generates a monthly rollup page.
+func (g *Generator) generateMonthlyPage(ctx context.Context, data templates.MonthlyData) error {
+	data.SiteURL = g.siteURL
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.NoIndex = g.noIndexAggregate
+	g.decorateProposals(data.Proposals)
+	component := templates.MonthlyIndexPage(data)
+
+	// Create directory path: dist/YYYY/MM/
+	dirPath := filepath.Join(g.distDir, fmt.Sprintf("%d", data.Year), fmt.Sprintf("%02d", data.Month))
+	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
+		return fmt.Errorf("failed to create monthly directory: %w", err)
+	}
+
 	filePath := filepath.Join(dirPath, "index.html")
-	return g.renderToFile(ctx, filePath, component)
+	return g.renderPage(ctx, filePath, component)
+}
+
+// generateCategoryPage generates a category page.
+func (g *Generator) generateCategoryPage(ctx context.Context, data templates.CategoryData) error {
+	data.SiteURL = g.siteURL
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.NoIndex = g.noIndexAggregate
+	data.FeedURL = g.siteURL + "/" + categoryFeedFilename(data.Name)
+	g.decorateProposals(data.Proposals)
+	component := templates.CategoryIndexPage(data)
+
+	// Create directory path: dist/category/<name>/
+	dirPath := filepath.Join(g.distDir, "category", filepath.FromSlash(data.Name))
+	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
+		return fmt.Errorf("failed to create category directory: %w", err)
+	}
+
+	filePath := filepath.Join(dirPath, "index.html")
+	return g.renderPage(ctx, filePath, component)
+}
+
+// generateYearReviewPage generates a year in review page.
+func (g *Generator) generateYearReviewPage(ctx context.Context, data templates.YearReviewData) error {
+	data.SiteURL = g.siteURL
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.NoIndex = g.noIndexAggregate
+	g.decorateProposals(data.NotableAccepted)
+	component := templates.YearReviewPage(data)
+
+	// Create directory path: dist/YYYY/
+	dirPath := filepath.Join(g.distDir, fmt.Sprintf("%d", data.Year))
+	if err := os.MkdirAll(dirPath, dirPerm); err != nil {
+		return fmt.Errorf("failed to create year directory: %w", err)
+	}
+
+	filePath := filepath.Join(dirPath, "review.html")
+	return g.renderPage(ctx, filePath, component)
+}
+
+// buildStatusHistory indexes the proposals of all weeks by issue number,
+// each sorted by ChangedAt (oldest first).
+func buildStatusHistory(weeks []*content.WeeklyContent) map[int][]content.ProposalContent {
+	history := make(map[int][]content.ProposalContent)
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		for _, p := range week.Proposals {
+			history[p.IssueNumber] = append(history[p.IssueNumber], p)
+		}
+	}
+	for _, entries := range history {
+		content.SortProposals(entries)
+	}
+	return history
+}
+
+// previousStatusSince returns when p's previous status was set, i.e. the
+// ChangedAt of the earlier entry that started the latest run of that status.
+// It returns the zero time if no such entry exists.
+func previousStatusSince(history []content.ProposalContent, p content.ProposalContent) time.Time {
+	if p.PreviousStatus == "" {
+		return time.Time{}
+	}
+	var since time.Time
+	var last parser.Status
+	for _, h := range history {
+		if !h.ChangedAt.Before(p.ChangedAt) {
+			break
+		}
+		if h.CurrentStatus == p.PreviousStatus && last != h.CurrentStatus {
+			since = h.ChangedAt
+		}
+		last = h.CurrentStatus
+	}
+	if last != p.PreviousStatus {
+		return time.Time{}
+	}
+	return since
+}
+
+// proposalFilename returns the filename of a proposal page within its week directory.
+func proposalFilename(issueNumber int) string {
+	return fmt.Sprintf("%d.html", issueNumber)
+}
+
+// validateWeeks checks the proposal page filenames of every week, so that a
+// collision is reported before any page is written.
+func validateWeeks(weeks []*content.WeeklyContent) error {
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		if err := validateProposalFilenames(week, proposalFilename); err != nil {
+			return fmt.Errorf("invalid proposal pages for %d-W%02d: %w", week.Year, week.Week, err)
+		}
+	}
+	return nil
+}
+
+// validateProposalFilenames reports an error if any proposal page of the week,
+// named by filename, would collide with a reserved file or with another
+// proposal page.
+func validateProposalFilenames(week *content.WeeklyContent, filename func(issueNumber int) string) error {
+	seen := make(map[string]bool, len(week.Proposals))
+	for _, p := range week.Proposals {
+		name := filename(p.IssueNumber)
+		if reservedFilenames[name] {
+			return fmt.Errorf("proposal #%d page %s collides with a reserved file", p.IssueNumber, name)
+		}
+		if seen[name] {
+			return fmt.Errorf("proposal #%d page %s is generated more than once", p.IssueNumber, name)
+		}
+		seen[name] = true
+	}
+	return nil
 }
 
 // generateProposalPage generates an individual proposal page.
 func (g *Generator) generateProposalPage(ctx context.Context, data templates.ProposalDetailData) error {
 	// Set the site URL for OGP tags
 	data.SiteURL = g.siteURL
-	component := templates.ProposalDetailPage(data)
+	data.Alternates = g.alternates
+	data.AuthorURL = g.authorURL()
+	data.BackLinkAnchor = g.backLinkAnchors
+	data.Title = g.proposalTitle(data.Title)
+	data.DisplayTitle = g.displayTitle(data.Title)
+	data.MaxLinks = g.maxLinks
+	data.SummaryRunes, data.ReadingMinutes = summaryStats(data.Summary)
+	data.Breadcrumbs = append(weekBreadcrumbs(data.Year, data.Week), templates.Breadcrumb{
+		Label: fmt.Sprintf("#%d", data.IssueNumber),
+		URL:   fmt.Sprintf("/%d/w%02d/%s", data.Year, data.Week, proposalFilename(data.IssueNumber)),
+	})
+	if g.provisional {
+		data.Provisional = !data.CurrentStatus.IsTerminal()
+	}
+	title, err := g.renderTitle("proposal", g.titleTemplates.proposal, TitleData{
+		Title:       data.Title,
+		Year:        data.Year,
+		Week:        data.Week,
+		IssueNumber: data.IssueNumber,
+	})
+	if err != nil {
+		return err
+	}
+	data.PageTitle = title
+	component := g.pageComponent(ProposalTemplateFile, data, templates.ProposalDetailPage(data))
 
 	// Create directory path: dist/YYYY/wWW/
 	dirPath := filepath.Join(g.distDir, fmt.Sprintf("%d", data.Year), fmt.Sprintf("w%02d", data.Week))
@@ -184,8 +1249,55 @@ func (g *Generator) generateProposalPage(ctx context.Context, data templates.Pro
 		return fmt.Errorf("failed to create proposal directory: %w", err)
 	}
 
-	filePath := filepath.Join(dirPath, fmt.Sprintf("%d.html", data.IssueNumber))
-	return g.renderToFile(ctx, filePath, component)
+	filePath := filepath.Join(dirPath, proposalFilename(data.IssueNumber))
+	return g.renderPage(ctx, filePath, component)
+}
+
+// GenerateStats reports how many HTML pages the last Generate call wrote and
+// how many it left unchanged because of WithIncremental.
+type GenerateStats struct {
+	Written int
+	Skipped int
+}
+
+// Stats returns the page counts of the last Generate call.
+func (g *Generator) Stats() GenerateStats {
+	return GenerateStats{
+		Written: int(g.pagesWritten.Load()),
+		Skipped: int(g.pagesSkipped.Load()),
+	}
+}
+
+// renderPage renders a page that depends only on part of the content.
+// In incremental mode the page is rendered to memory first and the existing
+// file is left untouched if its content hash matches.
+func (g *Generator) renderPage(ctx context.Context, filePath string, component templ.Component) error {
+	if !g.incremental {
+		return g.renderToFile(ctx, filePath, component)
+	}
+
+	var buf bytes.Buffer
+	if err := g.finalizePage(component).Render(ctx, &buf); err != nil {
+		return fmt.Errorf("failed to render component: %w", err)
+	}
+
+	if existing, err := os.ReadFile(filePath); err == nil && sha256.Sum256(existing) == sha256.Sum256(buf.Bytes()) {
+		g.pagesSkipped.Add(1)
+		return nil
+	}
+
+	if err := os.WriteFile(filePath, buf.Bytes(), filePerm); err != nil {
+		_ = os.Remove(filePath)
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	g.pagesWritten.Add(1)
+	return nil
+}
+
+// finalizePage applies the page-wide output options (WithHashedAssets,
+// WithNoIndex, WithMinify) to component.
+func (g *Generator) finalizePage(component templ.Component) templ.Component {
+	return g.withMinify(g.withNoIndexMeta(g.withHashedAssets(component)))
 }
 
 // renderToFile renders a templ component to a file.
@@ -205,17 +1317,30 @@ func (g *Generator) renderToFile(ctx context.Context, filePath string, component
 		}
 	}()
 
-	if err := component.Render(ctx, io.Writer(file)); err != nil {
+	if err := g.finalizePage(component).Render(ctx, io.Writer(file)); err != nil {
 		return fmt.Errorf("failed to render component: %w", err)
 	}
 
+	g.pagesWritten.Add(1)
 	return nil
 }
 
+// feedGenerator returns a FeedGenerator configured like g.
+func (g *Generator) feedGenerator() *FeedGenerator {
+	return NewFeedGenerator(
+		WithSiteURL(g.siteURL),
+		WithFeedUpdatedAt(g.useUpdatedAt),
+		WithFeedMaxTitleLength(g.maxTitleLength),
+		WithFeedStripTitlePrefix(g.stripTitlePrefix),
+		WithFeedItemTitleTemplate(g.titleTemplates.feedItem),
+		WithMaxItems(g.maxFeedItems),
+	)
+}
+
 // generateRSSFeed generates the RSS feed (feed.xml).
 // If writing fails, any partially written file is removed.
 func (g *Generator) generateRSSFeed(ctx context.Context, weeks []*content.WeeklyContent) error {
-	fg := NewFeedGenerator(WithSiteURL(g.siteURL))
+	fg := g.feedGenerator()
 
 	feedData, err := fg.GenerateFeed(ctx, weeks)
 	if err != nil {
@@ -232,6 +1357,60 @@ func (g *Generator) generateRSSFeed(ctx context.Context, weeks []*content.Weekly
 	return nil
 }
 
+// statusFeedStatuses lists the terminal statuses that get their own RSS feed.
+var statusFeedStatuses = []parser.Status{parser.StatusAccepted, parser.StatusDeclined}
+
+// categoryFeedFilename returns the filename of the RSS feed for category,
+// with slashes replaced, e.g. "feed-net-http.xml".
+func categoryFeedFilename(category string) string {
+	return fmt.Sprintf("feed-%s.xml", strings.ReplaceAll(category, "/", "-"))
+}
+
+// generateCategoryFeed writes the RSS feed of a category.
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateCategoryFeed(ctx context.Context, weeks []*content.WeeklyContent, category string) error {
+	feedData, err := g.feedGenerator().GenerateCategoryFeed(ctx, weeks, category)
+	if err != nil {
+		return err
+	}
+
+	filename := categoryFeedFilename(category)
+	feedPath := filepath.Join(g.distDir, filename)
+	if err := os.WriteFile(feedPath, feedData, filePerm); err != nil {
+		_ = os.Remove(feedPath)
+		return fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	return nil
+}
+
+// statusFeedFilename returns the filename of the RSS feed for status,
+// e.g. "feed-accepted.xml".
+func statusFeedFilename(status parser.Status) string {
+	return fmt.Sprintf("feed-%s.xml", status)
+}
+
+// generateStatusFeeds writes one filtered RSS feed per statusFeedStatuses entry.
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateStatusFeeds(ctx context.Context, weeks []*content.WeeklyContent) error {
+	fg := g.feedGenerator()
+
+	for _, status := range statusFeedStatuses {
+		feedData, err := fg.GenerateFeedFiltered(ctx, weeks, status)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s feed: %w", status, err)
+		}
+
+		filename := statusFeedFilename(status)
+		feedPath := filepath.Join(g.distDir, filename)
+		if err := os.WriteFile(feedPath, feedData, filePerm); err != nil {
+			_ = os.Remove(feedPath)
+			return fmt.Errorf("failed to write %s: %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
 // copyPublicFiles copies static files from web/public/ to dist/.
 // If the public directory doesn't exist, it returns without error.
 func (g *Generator) copyPublicFiles(ctx context.Context) error {
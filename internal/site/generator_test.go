@@ -2,14 +2,17 @@ package site
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/mazrean/go-proposal-review-meeting/internal/content"
 	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
 )
 
 func TestGenerator_Generate(t *testing.T) {
@@ -351,3 +354,825 @@ func TestGenerator_GenerateRSSWithMaxItems(t *testing.T) {
 		t.Errorf("feed.xml should contain at most 20 items, got %d", itemCount)
 	}
 }
+
+func TestGenerator_GenerateWithSubscribeSection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		wantFeeds bool
+	}{
+		{name: "enabled lists feed URLs", enabled: true, wantFeeds: true},
+		{name: "disabled omits section", enabled: false, wantFeeds: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+				WithSubscribeSection(tt.enabled),
+			)
+
+			if err := gen.Generate(context.Background(), nil); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			indexContent, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+			if err != nil {
+				t.Fatalf("Failed to read index.html: %v", err)
+			}
+
+			html := string(indexContent)
+			hasSection := strings.Contains(html, "subscribe-section")
+			if hasSection != tt.wantFeeds {
+				t.Errorf("subscribe section present = %v, want %v", hasSection, tt.wantFeeds)
+			}
+			hasLink := strings.Contains(html, `href="https://example.com/feed.xml"`)
+			if hasLink != tt.wantFeeds {
+				t.Errorf("RSS feed link present = %v, want %v", hasLink, tt.wantFeeds)
+			}
+		})
+	}
+}
+
+func TestGenerator_GenerateProposalFilenameCollision(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	valid := &content.WeeklyContent{
+		Year:      2026,
+		Week:      4,
+		CreatedAt: now.AddDate(0, 0, -7),
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 11111, Title: "proposal: valid", CurrentStatus: parser.StatusActive, ChangedAt: now.AddDate(0, 0, -7)},
+		},
+	}
+	week := &content.WeeklyContent{
+		Year:      2026,
+		Week:      5,
+		CreatedAt: now,
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 12345, Title: "proposal: first", CurrentStatus: parser.StatusActive, ChangedAt: now},
+			{IssueNumber: 12345, Title: "proposal: duplicate", CurrentStatus: parser.StatusAccepted, ChangedAt: now},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir))
+
+	err := gen.Generate(context.Background(), []*content.WeeklyContent{valid, week})
+	if err == nil {
+		t.Fatal("Generate() should return error when proposal pages collide")
+	}
+	if !strings.Contains(err.Error(), "12345.html") {
+		t.Errorf("error should name the colliding file, got: %v", err)
+	}
+
+	// The collision is detected before anything is written, including the
+	// pages of the valid week
+	for _, rel := range []string{"index.html", "2026/w04", "2026/w05"} {
+		if _, err := os.Stat(filepath.Join(distDir, filepath.FromSlash(rel))); !os.IsNotExist(err) {
+			t.Errorf("%s should not be written when proposal filenames collide", rel)
+		}
+	}
+}
+
+func TestGenerator_SummaryStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		summary     string
+		wantLength  string
+		wantReading string
+	}{
+		{
+			name:        "long Japanese summary",
+			summary:     strings.Repeat("提案の要約", 100),
+			wantLength:  "500文字",
+			wantReading: "約2分で読めます",
+		},
+		{
+			name:        "short fallback summary",
+			summary:     "要約はありません",
+			wantLength:  "8文字",
+			wantReading: "約1分で読めます",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+			week := &content.WeeklyContent{
+				Year: 2026,
+				Week: 5,
+				Proposals: []content.ProposalContent{
+					{IssueNumber: 12345, Title: "proposal: stats", CurrentStatus: parser.StatusActive, ChangedAt: now, Summary: tt.summary},
+				},
+			}
+
+			distDir := t.TempDir()
+			gen := NewGenerator(WithDistDir(distDir))
+			if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			page, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+			if err != nil {
+				t.Fatalf("failed to read proposal page: %v", err)
+			}
+			if !strings.Contains(string(page), tt.wantLength) {
+				t.Errorf("proposal page should show summary length %q", tt.wantLength)
+			}
+			if !strings.Contains(string(page), tt.wantReading) {
+				t.Errorf("proposal page should show reading time %q", tt.wantReading)
+			}
+		})
+	}
+}
+
+func TestValidateProposalFilenames(t *testing.T) {
+	t.Parallel()
+
+	week := &content.WeeklyContent{
+		Year:      2026,
+		Week:      5,
+		Proposals: []content.ProposalContent{{IssueNumber: 1}, {IssueNumber: 2}},
+	}
+
+	tests := []struct {
+		filename func(int) string
+		name     string
+		wantErr  string
+	}{
+		{
+			name:     "distinct proposal pages",
+			filename: proposalFilename,
+		},
+		{
+			name:     "collides with weekly index",
+			filename: func(int) string { return "index.html" },
+			wantErr:  "reserved",
+		},
+		{
+			name:     "collides with feed",
+			filename: func(int) string { return "feed.xml" },
+			wantErr:  "reserved",
+		},
+		{
+			name:     "collides with another proposal",
+			filename: func(int) string { return "0.html" },
+			wantErr:  "more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateProposalFilenames(week, tt.filename)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateProposalFilenames() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateProposalFilenames() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerator_GenerateMonthlyPages(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 2,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: january first", CurrentStatus: parser.StatusActive,
+					ChangedAt: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1002, Title: "proposal: january second", CurrentStatus: parser.StatusAccepted,
+					ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+				{IssueNumber: 2001, Title: "proposal: february", CurrentStatus: parser.StatusDeclined,
+					ChangedAt: time.Date(2026, 2, 1, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithMonthlyPages(true))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		january, err := os.ReadFile(filepath.Join(distDir, "2026", "01", "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read January rollup: %v", err)
+		}
+		html := string(january)
+		for _, want := range []string{
+			"proposal: january first",
+			`href="/2026/w02/1001.html"`,
+			"proposal: january second",
+			`href="/2026/w05/1002.html"`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("January rollup should contain %q", want)
+			}
+		}
+		if strings.Contains(html, "proposal: february") {
+			t.Error("January rollup should not contain February proposals")
+		}
+
+		if _, err := os.Stat(filepath.Join(distDir, "2026", "02", "index.html")); err != nil {
+			t.Errorf("February rollup should exist: %v", err)
+		}
+
+		home, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read index.html: %v", err)
+		}
+		if !strings.Contains(string(home), `href="/2026/01/"`) {
+			t.Error("home page should link to the January rollup")
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		if _, err := os.Stat(filepath.Join(distDir, "2026", "01")); !os.IsNotExist(err) {
+			t.Error("monthly rollup should not be generated unless enabled")
+		}
+		home, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read index.html: %v", err)
+		}
+		if strings.Contains(string(home), "monthly-archive") {
+			t.Error("home page should not list months unless enabled")
+		}
+	})
+}
+
+func TestGenerator_GenerateCategoryPages(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: net/http: add X", CurrentStatus: parser.StatusActive,
+					ChangedAt: time.Date(2026, 1, 21, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: net/http: add X", CurrentStatus: parser.StatusAccepted,
+					ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+				{IssueNumber: 1002, Title: "proposal: add Y", CurrentStatus: parser.StatusDeclined,
+					ChangedAt: time.Date(2026, 1, 29, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithCategoryPages(true))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		netHTTP, err := os.ReadFile(filepath.Join(distDir, "category", "net", "http", "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read net/http category page: %v", err)
+		}
+		html := string(netHTTP)
+		// The proposal is listed once, linking to its latest week
+		if !strings.Contains(html, `href="/2026/w05/1001.html"`) {
+			t.Error("net/http page should link to the latest entry of #1001")
+		}
+		if strings.Contains(html, `href="/2026/w04/1001.html"`) {
+			t.Error("net/http page should not list the older entry of #1001")
+		}
+		if strings.Contains(html, "proposal: add Y") {
+			t.Error("net/http page should not list proposals of other categories")
+		}
+
+		other, err := os.ReadFile(filepath.Join(distDir, "category", content.OtherCategory, "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read other category page: %v", err)
+		}
+		if !strings.Contains(string(other), "proposal: add Y") {
+			t.Error("other page should list proposals without a package")
+		}
+
+		sitemap, err := os.ReadFile(filepath.Join(distDir, sitemapFilename))
+		if err != nil {
+			t.Fatalf("failed to read sitemap: %v", err)
+		}
+		if !strings.Contains(string(sitemap), "/category/net/http/") {
+			t.Error("sitemap should list category pages")
+		}
+
+		// Each category has a feed, advertised only on its own page
+		if _, err := os.Stat(filepath.Join(distDir, "feed-net-http.xml")); err != nil {
+			t.Errorf("net/http feed should exist: %v", err)
+		}
+		if !strings.Contains(html, `href="https://example.com/feed-net-http.xml"`) {
+			t.Error("net/http page should advertise its feed")
+		}
+		home, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read index.html: %v", err)
+		}
+		if strings.Contains(string(home), "feed-net-http.xml") {
+			t.Error("home page should not advertise category feeds")
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		if _, err := os.Stat(filepath.Join(distDir, "category")); !os.IsNotExist(err) {
+			t.Error("category pages should not be generated unless enabled")
+		}
+	})
+}
+
+func TestGenerator_GenerateYearInReview(t *testing.T) {
+	t.Parallel()
+
+	at := func(month time.Month, day int) time.Time {
+		return time.Date(2026, month, day, 12, 0, 0, 0, time.UTC)
+	}
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2025,
+			Week: 50,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 900, Title: "proposal: last year", CurrentStatus: parser.StatusAccepted,
+					ChangedAt: time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 2,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: likely then accepted", CurrentStatus: parser.StatusLikelyAccept, ChangedAt: at(1, 7)},
+				{IssueNumber: 1002, Title: "proposal: accepted early", CurrentStatus: parser.StatusAccepted, ChangedAt: at(1, 8)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1001, Title: "proposal: likely then accepted", CurrentStatus: parser.StatusAccepted, ChangedAt: at(1, 28)},
+				{IssueNumber: 1003, Title: "proposal: declined a", CurrentStatus: parser.StatusDeclined, ChangedAt: at(1, 29)},
+				{IssueNumber: 1004, Title: "proposal: declined b", CurrentStatus: parser.StatusDeclined, ChangedAt: at(1, 30)},
+			},
+		},
+	}
+
+	reviews := templates.ConvertToYearReviews(weeks)
+	if len(reviews) != 2 {
+		t.Fatalf("expected 2 year reviews, got %d", len(reviews))
+	}
+	review := reviews[0]
+	if review.Year != 2026 {
+		t.Fatalf("first review year = %d, want 2026", review.Year)
+	}
+	if review.TotalProposals != 4 {
+		t.Errorf("TotalProposals = %d, want 4", review.TotalProposals)
+	}
+	if review.Accepted != 2 || review.Declined != 2 {
+		t.Errorf("Accepted/Declined = %d/%d, want 2/2", review.Accepted, review.Declined)
+	}
+	if rate, ok := review.AcceptanceRate(); !ok || rate != 0.5 {
+		t.Errorf("AcceptanceRate() = %v, %v, want 0.5, true", rate, ok)
+	}
+	if len(review.BusiestWeeks) == 0 || review.BusiestWeeks[0].Week != 5 {
+		t.Errorf("BusiestWeeks = %+v, want week 5 first", review.BusiestWeeks)
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithYearInReview(true))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		data, err := os.ReadFile(filepath.Join(distDir, "2026", "review.html"))
+		if err != nil {
+			t.Fatalf("failed to read 2026 review: %v", err)
+		}
+		html := string(data)
+		for _, want := range []string{
+			`<dd class="review-total text-2xl font-bold text-[var(--text-primary)]">4件</dd>`,
+			`<dd class="review-accepted text-2xl font-bold text-[var(--text-primary)]">2件</dd>`,
+			`<dd class="review-acceptance-rate text-2xl font-bold text-[var(--text-primary)]">50%</dd>`,
+			`href="/2026/w05/"`,
+			`href="/2026/w05/1001.html"`,
+			`href="/2026/w02/1002.html"`,
+		} {
+			if !strings.Contains(html, want) {
+				t.Errorf("2026 review should contain %q", want)
+			}
+		}
+		if strings.Contains(html, "proposal: last year") {
+			t.Error("2026 review should not contain 2025 proposals")
+		}
+
+		sitemap, err := os.ReadFile(filepath.Join(distDir, sitemapFilename))
+		if err != nil {
+			t.Fatalf("failed to read sitemap: %v", err)
+		}
+		if !strings.Contains(string(sitemap), "/2026/review.html</loc>") {
+			t.Error("sitemap should list the 2026 review")
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		if _, err := os.Stat(filepath.Join(distDir, "2026", "review.html")); !os.IsNotExist(err) {
+			t.Error("year in review should not be generated unless enabled")
+		}
+	})
+}
+
+func TestGenerator_GenerateMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	// Large fixture: 120 weeks with a handful of proposals each
+	base := time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC)
+	var weeks []*content.WeeklyContent
+	for i := range 120 {
+		at := base.AddDate(0, 0, 7*i)
+		year, week := at.ISOWeek()
+		wc := &content.WeeklyContent{Year: year, Week: week, CreatedAt: at}
+		for j := range 5 {
+			wc.Proposals = append(wc.Proposals, content.ProposalContent{
+				IssueNumber:   10000 + i*10 + j,
+				Title:         fmt.Sprintf("proposal: %d-%d", i, j),
+				CurrentStatus: parser.StatusActive,
+				ChangedAt:     at,
+			})
+		}
+		weeks = append(weeks, wc)
+	}
+
+	for _, maxInFlight := range []int{1, 3, 8} {
+		t.Run(fmt.Sprintf("max=%d", maxInFlight), func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			current, peak := 0, 0
+			distDir := t.TempDir()
+			gen := NewGenerator(WithDistDir(distDir), WithMaxInFlight(maxInFlight))
+			gen.inFlightHook = func(delta int) {
+				mu.Lock()
+				defer mu.Unlock()
+				current += delta
+				peak = max(peak, current)
+			}
+
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			if peak > maxInFlight {
+				t.Errorf("peak concurrent weeks = %d, want <= %d", peak, maxInFlight)
+			}
+			if current != 0 {
+				t.Errorf("weeks still in flight after Generate = %d", current)
+			}
+
+			// Every page must be written regardless of concurrency
+			last := weeks[len(weeks)-1]
+			page := filepath.Join(distDir, fmt.Sprintf("%d", last.Year), fmt.Sprintf("w%02d", last.Week),
+				fmt.Sprintf("%d.html", last.Proposals[4].IssueNumber))
+			if _, err := os.Stat(page); err != nil {
+				t.Errorf("expected proposal page %s: %v", page, err)
+			}
+		})
+	}
+}
+
+func TestGenerator_GenerateMaxInFlightError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	weeks := []*content.WeeklyContent{
+		{Year: 2026, Week: 4, Proposals: []content.ProposalContent{{IssueNumber: 1, ChangedAt: now}}},
+		{Year: 2026, Week: 5, Proposals: []content.ProposalContent{{IssueNumber: 2, ChangedAt: now}, {IssueNumber: 2, ChangedAt: now}}},
+	}
+
+	gen := NewGenerator(WithDistDir(t.TempDir()), WithMaxInFlight(4))
+	err := gen.Generate(context.Background(), weeks)
+	if err == nil || !strings.Contains(err.Error(), "2026-W05") {
+		t.Errorf("Generate() error = %v, want error for 2026-W05", err)
+	}
+}
+
+func TestGenerator_Incremental(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 11111, Title: "proposal: unchanged", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusLikelyAccept, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 6,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 22222, Title: "proposal: edited", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusHold, ChangedAt: time.Date(2026, 2, 4, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithIncremental(true))
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("first Generate() error = %v", err)
+	}
+	first := gen.Stats()
+	if first.Written == 0 || first.Skipped != 0 {
+		t.Fatalf("first run stats = %+v, want only written pages", first)
+	}
+
+	// Backdate every file so that a rewrite is detectable from the mtime
+	old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	paths := map[string]string{
+		"unchanged": filepath.Join(distDir, "2026", "w05", "11111.html"),
+		"edited":    filepath.Join(distDir, "2026", "w06", "22222.html"),
+		"weekly":    filepath.Join(distDir, "2026", "w05", "index.html"),
+		"home":      filepath.Join(distDir, "index.html"),
+		"feed":      filepath.Join(distDir, "feed.xml"),
+	}
+	for _, path := range paths {
+		if err := os.Chtimes(path, old, old); err != nil {
+			t.Fatalf("failed to backdate %s: %v", path, err)
+		}
+	}
+
+	weeks[1].Proposals[0].Summary = "## 概要\n\n要約を追加した。"
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("second Generate() error = %v", err)
+	}
+
+	modified := func(name string) bool {
+		t.Helper()
+		info, err := os.Stat(paths[name])
+		if err != nil {
+			t.Fatalf("failed to stat %s: %v", paths[name], err)
+		}
+		return !info.ModTime().Equal(old)
+	}
+	if modified("unchanged") {
+		t.Error("unchanged proposal page should not be rewritten")
+	}
+	if modified("weekly") {
+		t.Error("unchanged weekly index should not be rewritten")
+	}
+	for _, name := range []string{"edited", "home", "feed"} {
+		if !modified(name) {
+			t.Errorf("%s should be rewritten", name)
+		}
+	}
+
+	second := gen.Stats()
+	if second.Skipped == 0 {
+		t.Errorf("second run stats = %+v, want skipped pages", second)
+	}
+	if second.Written+second.Skipped != first.Written {
+		t.Errorf("second run stats = %+v, want %d pages in total", second, first.Written)
+	}
+}
+
+func TestGenerator_GenerateWeek(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 11111, Title: "proposal: other week", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusLikelyAccept, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 6,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 22222, Title: "proposal: edited", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusHold, ChangedAt: time.Date(2026, 2, 4, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir))
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	// Backdate every file so that a rewrite is detectable from the mtime
+	old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	paths := map[string]string{
+		"other index":    filepath.Join(distDir, "2026", "w05", "index.html"),
+		"other proposal": filepath.Join(distDir, "2026", "w05", "11111.html"),
+		"target index":   filepath.Join(distDir, "2026", "w06", "index.html"),
+		"target page":    filepath.Join(distDir, "2026", "w06", "22222.html"),
+		"home":           filepath.Join(distDir, "index.html"),
+		"feed":           filepath.Join(distDir, "feed.xml"),
+	}
+	for _, path := range paths {
+		if err := os.Chtimes(path, old, old); err != nil {
+			t.Fatalf("failed to backdate %s: %v", path, err)
+		}
+	}
+
+	weeks[1].Proposals[0].Summary = "## 概要\n\n要約を追加した。"
+	if err := gen.GenerateWeek(context.Background(), weeks[1], weeks); err != nil {
+		t.Fatalf("GenerateWeek() error = %v", err)
+	}
+
+	for name, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("failed to stat %s: %v", path, err)
+		}
+		wantModified := strings.HasPrefix(name, "target")
+		if modified := !info.ModTime().Equal(old); modified != wantModified {
+			t.Errorf("%s modified = %v, want %v", name, modified, wantModified)
+		}
+	}
+
+	page, err := os.ReadFile(paths["target page"])
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	if !strings.Contains(string(page), "要約を追加した。") {
+		t.Error("regenerated proposal page should contain the new summary")
+	}
+	index, err := os.ReadFile(paths["target index"])
+	if err != nil {
+		t.Fatalf("failed to read weekly index: %v", err)
+	}
+	if !strings.Contains(string(index), `href="/2026/w05/"`) {
+		t.Error("regenerated weekly index should still link the previous week")
+	}
+	if stats := gen.Stats(); stats.Written != 2 {
+		t.Errorf("Stats().Written = %d, want 2", stats.Written)
+	}
+}
+
+func TestGenerator_StatsPage(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1, Title: "proposal: one", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+				{IssueNumber: 2, Title: "proposal: two", CurrentStatus: parser.StatusDeclined, ChangedAt: changedAt},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 6,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 1, Title: "proposal: one", CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt.AddDate(0, 0, 7)},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, "stats", "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read stats page: %v", err)
+	}
+	html := string(data)
+	for _, want := range []string{
+		"2週で3件のProposal更新",
+		`<dd class="stats-total-accepted text-2xl font-bold text-[var(--text-primary)]">2件</dd>`,
+		`<dd class="stats-total-declined text-2xl font-bold text-[var(--text-primary)]">1件</dd>`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("stats page should contain %q", want)
+		}
+	}
+
+	sitemap, err := os.ReadFile(filepath.Join(distDir, sitemapFilename))
+	if err != nil {
+		t.Fatalf("failed to read sitemap: %v", err)
+	}
+	if !strings.Contains(string(sitemap), "<loc>https://example.com/stats/</loc>") {
+		t.Error("sitemap should list the stats page")
+	}
+}
+
+func TestGenerator_NotFoundPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		weeks      []*content.WeeklyContent
+		wantLatest string
+	}{
+		{
+			name: "links to home and the latest week",
+			weeks: []*content.WeeklyContent{
+				{Year: 2026, Week: 5, Proposals: []content.ProposalContent{{IssueNumber: 1, Title: "proposal: one", CurrentStatus: parser.StatusActive}}},
+				{Year: 2026, Week: 6, Proposals: []content.ProposalContent{{IssueNumber: 2, Title: "proposal: two", CurrentStatus: parser.StatusActive}}},
+			},
+			wantLatest: `href="/2026/w06/"`,
+		},
+		{
+			name: "generated without content",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(WithDistDir(distDir))
+			if err := gen.Generate(context.Background(), tt.weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, notFoundFilename))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", notFoundFilename, err)
+			}
+			html := string(data)
+			if !strings.Contains(html, `href="/"`) {
+				t.Error("404 page should link to the home page")
+			}
+			if !strings.Contains(html, `<link rel="alternate" type="application/rss+xml"`) {
+				t.Error("404 page should include RSS autodiscovery")
+			}
+			if tt.wantLatest != "" && !strings.Contains(html, tt.wantLatest) {
+				t.Errorf("404 page should link to the latest week with %s", tt.wantLatest)
+			}
+			if tt.wantLatest == "" && strings.Contains(html, "latest-week-link") {
+				t.Error("404 page should not link to a week without content")
+			}
+		})
+	}
+}
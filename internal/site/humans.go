@@ -0,0 +1,63 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// humansFilename is the name of the humans.txt file crediting the people
+// and data behind the site.
+const humansFilename = "humans.txt"
+
+// humansTxtURL is the site-relative URL of humans.txt, linked from every page
+// with rel="author" when the file is generated.
+const humansTxtURL = "/" + humansFilename
+
+// buildHumansTxt builds humans.txt (https://humanstxt.org/) listing the
+// configured maintainers, the data source and the tool that built the site.
+func (g *Generator) buildHumansTxt() []byte {
+	var sb strings.Builder
+
+	sb.WriteString("/* TEAM */\n")
+	for _, m := range g.maintainers {
+		fmt.Fprintf(&sb, "Maintainer: %s\n", m)
+	}
+
+	sb.WriteString("\n/* THANKS */\n")
+	sb.WriteString("Data source: Go proposal review meeting minutes\n")
+	sb.WriteString("Minutes: https://github.com/golang/go/issues/33502\n")
+	sb.WriteString("Proposal process: https://github.com/golang/proposal\n")
+
+	sb.WriteString("\n/* SITE */\n")
+	fmt.Fprintf(&sb, "Site: %s/\n", g.siteURL)
+	sb.WriteString("Software: go-proposal-review-meeting (https://github.com/mazrean/go-proposal-review-meeting)\n")
+	sb.WriteString("Language: Japanese\n")
+
+	return []byte(sb.String())
+}
+
+// generateHumansTxt writes humans.txt.
+func (g *Generator) generateHumansTxt(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	humansPath := filepath.Join(g.distDir, humansFilename)
+	if err := os.WriteFile(humansPath, g.buildHumansTxt(), filePerm); err != nil {
+		_ = os.Remove(humansPath)
+		return fmt.Errorf("failed to write %s: %w", humansFilename, err)
+	}
+	return nil
+}
+
+// authorURL returns the URL linked with rel="author", or an empty string if
+// humans.txt is not generated.
+func (g *Generator) authorURL() string {
+	if !g.humansTxt {
+		return ""
+	}
+	return humansTxtURL
+}
@@ -0,0 +1,93 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_GenerateHumansTxt(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: humans test",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	const authorLink = `<link rel="author" href="/humans.txt">`
+
+	tests := []struct {
+		name    string
+		opts    []Option
+		enabled bool
+	}{
+		{
+			name:    "credits the configured maintainer when enabled",
+			opts:    []Option{WithHumansTxt("Jane Doe <https://github.com/janedoe>")},
+			enabled: true,
+		},
+		{name: "not generated by default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(append([]Option{
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+			}, tt.opts...)...)
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			home, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+			if err != nil {
+				t.Fatalf("failed to read index.html: %v", err)
+			}
+			if got := strings.Contains(string(home), authorLink); got != tt.enabled {
+				t.Errorf("index.html contains %s = %v, want %v", authorLink, got, tt.enabled)
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, humansFilename))
+			if !tt.enabled {
+				if !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("%s should not exist, got err = %v", humansFilename, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", humansFilename, err)
+			}
+
+			for _, want := range []string{
+				"Maintainer: Jane Doe <https://github.com/janedoe>\n",
+				"https://github.com/golang/proposal",
+				"Site: https://example.com/\n",
+			} {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("%s should contain %q:\n%s", humansFilename, want, data)
+				}
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -885,8 +886,8 @@ func TestIntegration_LargeDatasetFileCount(t *testing.T) {
 			t.Fatalf("failed to walk dist directory: %v", err)
 		}
 
-		// Expected: 1 index + 10 weekly indexes + 50 proposal pages = 61
-		expectedCount := 1 + 10 + 50
+		// Expected: 1 index + 1 archive + 1 stats + 1 404 + 10 weekly indexes + 50 proposal pages = 64
+		expectedCount := 1 + 1 + 1 + 1 + 10 + 50
 		if htmlCount != expectedCount {
 			t.Errorf("expected %d HTML files, got %d", expectedCount, htmlCount)
 		}
@@ -1212,7 +1213,7 @@ func TestIntegration_MarkdownToHTMLPipeline(t *testing.T) {
 		}
 	})
 
-	// Verify: Total HTML file count (1 home + 1 weekly index + 5 proposals = 7)
+	// Verify: Total HTML file count (1 home + 1 archive + 1 stats + 1 404 + 1 weekly index + 5 proposals = 10)
 	t.Run("correct total HTML file count", func(t *testing.T) {
 		var htmlCount int
 		err := filepath.Walk(distDir, func(path string, info os.FileInfo, err error) error {
@@ -1228,7 +1229,7 @@ func TestIntegration_MarkdownToHTMLPipeline(t *testing.T) {
 			t.Fatalf("failed to walk dist directory: %v", err)
 		}
 
-		expectedCount := 7 // 1 home + 1 weekly index + 5 proposal pages
+		expectedCount := 10 // 1 home + 1 archive + 1 stats + 1 404 + 1 weekly index + 5 proposal pages
 		if htmlCount != expectedCount {
 			t.Errorf("expected %d HTML files, got %d", expectedCount, htmlCount)
 		}
@@ -1507,8 +1508,8 @@ func TestIntegration_ContentToSiteToFeed(t *testing.T) {
 			t.Fatalf("failed to walk dist directory: %v", err)
 		}
 
-		// Expected: 1 home + 2 weekly indexes + 10 proposal pages = 13
-		expectedCount := 1 + 2 + 10
+		// Expected: 1 home + 1 archive + 1 stats + 1 404 + 2 weekly indexes + 10 proposal pages = 16
+		expectedCount := 1 + 1 + 1 + 1 + 2 + 10
 		if htmlCount != expectedCount {
 			t.Errorf("expected %d HTML files, got %d", expectedCount, htmlCount)
 		}
@@ -1800,3 +1801,1021 @@ func TestIntegration_TemplDataBinding(t *testing.T) {
 		}
 	})
 }
+
+// TestIntegration_ProvisionalStatusBadge verifies that non-final statuses are
+// visually de-emphasized when WithProvisionalStatuses is enabled.
+func TestIntegration_ProvisionalStatusBadge(t *testing.T) {
+	t.Parallel()
+
+	week := &content.WeeklyContent{
+		Year:      2026,
+		Week:      5,
+		CreatedAt: time.Now(),
+		Proposals: []content.ProposalContent{
+			{
+				IssueNumber:    1,
+				Title:          "proposal: accepted test",
+				PreviousStatus: parser.StatusLikelyAccept,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Now(),
+			},
+			{
+				IssueNumber:    2,
+				Title:          "proposal: likely accept test",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusLikelyAccept,
+				ChangedAt:      time.Now(),
+			},
+			{
+				IssueNumber:    3,
+				Title:          "proposal: retracted test",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusRetracted,
+				ChangedAt:      time.Now(),
+			},
+		},
+	}
+
+	badgeRe := regexp.MustCompile(`<span class="(inline-flex items-center px-2 py-1 rounded [^"]*)"[^>]*>\s*(accepted|likely_accept|retracted)\s*</span>`)
+	badgeClasses := func(t *testing.T, path string) map[string]string {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", path, err)
+		}
+		classes := make(map[string]string)
+		for _, m := range badgeRe.FindAllStringSubmatch(string(data), -1) {
+			classes[m[2]] = m[1]
+		}
+		return classes
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithProvisionalStatuses(true))
+		if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		weekly := badgeClasses(t, filepath.Join(distDir, "2026", "w05", "index.html"))
+		if !strings.Contains(weekly["likely_accept"], "status-provisional") {
+			t.Errorf("likely_accept badge class = %q, want status-provisional", weekly["likely_accept"])
+		}
+		for _, status := range []string{"accepted", "retracted"} {
+			if class, ok := weekly[status]; !ok || strings.Contains(class, "status-provisional") {
+				t.Errorf("%s badge class = %q, want no status-provisional", status, class)
+			}
+		}
+
+		detail := badgeClasses(t, filepath.Join(distDir, "2026", "w05", "2.html"))
+		if !strings.Contains(detail["likely_accept"], "status-provisional") {
+			t.Errorf("proposal page likely_accept badge class = %q, want status-provisional", detail["likely_accept"])
+		}
+		if class, ok := badgeClasses(t, filepath.Join(distDir, "2026", "w05", "3.html"))["retracted"]; !ok || strings.Contains(class, "status-provisional") {
+			t.Errorf("proposal page retracted badge class = %q, want no status-provisional", class)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir))
+		if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read weekly index: %v", err)
+		}
+		if strings.Contains(string(data), "status-provisional") {
+			t.Error("provisional style should not be applied unless enabled")
+		}
+	})
+}
+
+// TestIntegration_TitleTemplates verifies that configured title templates are
+// used for both the <title> element and og:title.
+func TestIntegration_TitleTemplates(t *testing.T) {
+	t.Parallel()
+
+	week := &content.WeeklyContent{
+		Year:      2026,
+		Week:      5,
+		CreatedAt: time.Now(),
+		Proposals: []content.ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          "proposal: add foo",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Now(),
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(
+		WithDistDir(distDir),
+		WithGeneratorSiteTitle("Go Digest"),
+		WithHomeTitleTemplate("{{.SiteTitle}}"),
+		WithWeeklyTitleTemplate("Week {{.Week}}, {{.Year}} — {{.SiteTitle}}"),
+		WithProposalTitleTemplate("#{{.IssueNumber}} {{.Title}} — {{.SiteTitle}}"),
+	)
+	if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		path  string
+		title string
+	}{
+		{"home", "index.html", "Go Digest"},
+		{"weekly", filepath.Join("2026", "w05", "index.html"), "Week 5, 2026 — Go Digest"},
+		{"proposal", filepath.Join("2026", "w05", "12345.html"), "#12345 proposal: add foo — Go Digest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join(distDir, tt.path))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.path, err)
+			}
+			html := string(data)
+
+			if want := "<title>" + tt.title + "</title>"; !strings.Contains(html, want) {
+				t.Errorf("page should contain %q", want)
+			}
+			if want := `<meta property="og:title" content="` + tt.title + `"`; !strings.Contains(html, want) {
+				t.Errorf("page should contain %q", want)
+			}
+		})
+	}
+
+	t.Run("invalid template", func(t *testing.T) {
+		t.Parallel()
+
+		gen := NewGenerator(
+			WithDistDir(t.TempDir()),
+			WithProposalTitleTemplate("{{.Missing}}"),
+		)
+		if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err == nil {
+			t.Error("Generate() should fail for a template referencing an unknown field")
+		}
+	})
+}
+
+// TestIntegration_StatusContext verifies that a proposal updated across two
+// weeks shows when its previous status was set.
+func TestIntegration_StatusContext(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: add foo",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusLikelyAccept,
+					ChangedAt:      time.Date(2026, 1, 23, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: add foo",
+					PreviousStatus: parser.StatusLikelyAccept,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithStatusContext(true))
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	html := string(data)
+
+	start := strings.Index(html, `class="status-context`)
+	if start < 0 {
+		t.Fatal("proposal page should contain the status context line")
+	}
+	line := html[start:]
+	line = line[:strings.Index(line, "</p>")]
+
+	// Current status and date first, then the previous status and since when
+	pos := 0
+	for _, want := range []string{"2026-01-30", "accepted", "2026-01-23", "likely_accept"} {
+		i := strings.Index(line[pos:], want)
+		if i < 0 {
+			t.Fatalf("status context %q should contain %q after position %d", line, want, pos)
+		}
+		pos += i + len(want)
+	}
+
+	// Without the option the line is omitted
+	plainDir := t.TempDir()
+	if err := NewGenerator(WithDistDir(plainDir)).Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	plain, err := os.ReadFile(filepath.Join(plainDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	if strings.Contains(string(plain), "status-context") {
+		t.Error("status context should not be rendered unless enabled")
+	}
+}
+
+// TestIntegration_BackLinkAnchors verifies that proposal pages link back to
+// the proposal's position on the weekly index, and that the anchors stay off
+// other list pages, which may show a proposal more than once.
+func TestIntegration_BackLinkAnchors(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: add foo",
+					PreviousStatus: parser.StatusLikelyAccept,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 4,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: add foo",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusLikelyAccept,
+					ChangedAt:      time.Date(2026, 1, 22, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithBackLinkAnchors(true), WithMonthlyPages(true))
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	proposal, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	if !strings.Contains(string(proposal), `href="/2026/w05/#p-12345"`) {
+		t.Error("proposal page back link should target /2026/w05/#p-12345")
+	}
+
+	index, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read weekly index: %v", err)
+	}
+	if !strings.Contains(string(index), `id="p-12345"`) {
+		t.Error("weekly index should have an anchor with id p-12345")
+	}
+
+	monthly, err := os.ReadFile(filepath.Join(distDir, "2026", "01", "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read monthly page: %v", err)
+	}
+	for _, detail := range []string{"/2026/w04/12345.html", "/2026/w05/12345.html"} {
+		if !strings.Contains(string(monthly), detail) {
+			t.Fatalf("monthly page should list #12345 from both weeks, missing %s", detail)
+		}
+	}
+	if strings.Contains(string(monthly), `id="p-12345"`) {
+		t.Error("monthly page should not repeat the weekly index anchor id p-12345")
+	}
+}
+
+// TestIntegration_MaxTitleLength verifies that long titles are truncated in
+// headings and feeds while the document title keeps the full text.
+func TestIntegration_MaxTitleLength(t *testing.T) {
+	t.Parallel()
+
+	fullTitle := "proposal: " + strings.Repeat("とても長いタイトル", 10)
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          fullTitle,
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithMaxTitleLength(20))
+	if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	short := truncateRunes(fullTitle, 20)
+
+	data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	html := string(data)
+
+	h1 := regexp.MustCompile(`(?s)<h1 class="text-2xl[^"]*">(.*?)</h1>`).FindStringSubmatch(html)
+	if h1 == nil {
+		t.Fatal("proposal page should have an h1 heading")
+	}
+	if got := strings.TrimSpace(h1[1]); got != short {
+		t.Errorf("heading = %q, want %q", got, short)
+	}
+	if !strings.Contains(html, "<title>#12345 "+fullTitle) {
+		t.Error("<title> should contain the full title")
+	}
+	if !strings.Contains(html, `<meta property="og:title" content="#12345 `+fullTitle+`"`) {
+		t.Error("og:title should contain the full title")
+	}
+
+	feed, err := os.ReadFile(filepath.Join(distDir, "feed.xml"))
+	if err != nil {
+		t.Fatalf("failed to read feed.xml: %v", err)
+	}
+	if strings.Contains(string(feed), fullTitle) {
+		t.Error("feed should not contain the untruncated title")
+	}
+	if !strings.Contains(string(feed), short) {
+		t.Errorf("feed should contain the truncated title %q", short)
+	}
+}
+
+// TestIntegration_StripTitlePrefix verifies that the "proposal:" prefix is
+// removed from page titles, og:title and feeds while the weekly index still
+// lists the proposal.
+func TestIntegration_StripTitlePrefix(t *testing.T) {
+	t.Parallel()
+
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          "proposal: net/http: add X",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithStripTitlePrefix(true))
+	if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if week.Proposals[0].Title != "proposal: net/http: add X" {
+		t.Errorf("stored Title = %q, should keep the prefix", week.Proposals[0].Title)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	html := string(data)
+	if !strings.Contains(html, "<title>#12345 net/http: add X") {
+		t.Error("<title> should omit the proposal: prefix")
+	}
+	if !strings.Contains(html, `<meta property="og:title" content="#12345 net/http: add X"`) {
+		t.Error("og:title should omit the proposal: prefix")
+	}
+
+	for _, name := range []string{"feed.xml", filepath.Join("2026", "w05", "index.html")} {
+		data, err := os.ReadFile(filepath.Join(distDir, name))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", name, err)
+		}
+		if strings.Contains(string(data), "proposal: net/http") {
+			t.Errorf("%s should omit the proposal: prefix", name)
+		}
+		if !strings.Contains(string(data), "net/http: add X") {
+			t.Errorf("%s should contain the title without prefix", name)
+		}
+	}
+}
+
+func TestIntegration_RecentDecisionRibbon(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 15, 9, 0, 0, 0, time.UTC)
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 20,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    11111,
+					Title:          "proposal: accepted yesterday",
+					PreviousStatus: parser.StatusLikelyAccept,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      now.AddDate(0, 0, -1),
+				},
+			},
+		},
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    22222,
+					Title:          "proposal: accepted months ago",
+					PreviousStatus: parser.StatusLikelyAccept,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(
+		WithDistDir(distDir),
+		WithRecentDecisionWindow(7*24*time.Hour),
+		WithClock(func() time.Time { return now }),
+	)
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		page       string
+		wantRibbon bool
+	}{
+		{name: "accepted yesterday", page: filepath.Join("2026", "w20", "index.html"), wantRibbon: true},
+		{name: "accepted months ago", page: filepath.Join("2026", "w05", "index.html"), wantRibbon: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join(distDir, tt.page))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.page, err)
+			}
+			if got := strings.Contains(string(data), "新しい決定"); got != tt.wantRibbon {
+				t.Errorf("ribbon present = %v, want %v", got, tt.wantRibbon)
+			}
+		})
+	}
+
+	home, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read home page: %v", err)
+	}
+	if got := strings.Count(string(home), "新しい決定 1件"); got != 1 {
+		t.Errorf("home page shows %d recent decision counts, want 1", got)
+	}
+}
+
+func TestIntegration_HreflangAlternates(t *testing.T) {
+	t.Parallel()
+
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{
+				IssueNumber:    12345,
+				Title:          "proposal: hreflang",
+				PreviousStatus: parser.StatusActive,
+				CurrentStatus:  parser.StatusAccepted,
+				ChangedAt:      time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(
+		WithDistDir(distDir),
+		WithGeneratorSiteURL("https://example.com"),
+		WithAlternateLanguage("ja", "https://example.com"),
+		WithAlternateLanguage("en", "https://example.com/en/"),
+	)
+	if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		page string
+		want []string
+	}{
+		{
+			name: "proposal page",
+			page: filepath.Join("2026", "w05", "12345.html"),
+			want: []string{
+				`<link rel="alternate" hreflang="ja" href="https://example.com/2026/w05/12345.html">`,
+				`<link rel="alternate" hreflang="en" href="https://example.com/en/2026/w05/12345.html">`,
+			},
+		},
+		{
+			name: "weekly index",
+			page: filepath.Join("2026", "w05", "index.html"),
+			want: []string{`<link rel="alternate" hreflang="en" href="https://example.com/en/2026/w05/">`},
+		},
+		{
+			name: "home page",
+			page: "index.html",
+			want: []string{`<link rel="alternate" hreflang="en" href="https://example.com/en/">`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join(distDir, tt.page))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.page, err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("%s should contain %s", tt.page, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIntegration_WeeklyTableLayout(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	week := &content.WeeklyContent{
+		Year: 2026,
+		Week: 5,
+		Proposals: []content.ProposalContent{
+			{IssueNumber: 11111, Title: "proposal: table first", PreviousStatus: parser.StatusLikelyAccept, CurrentStatus: parser.StatusAccepted, ChangedAt: changedAt},
+			{IssueNumber: 22222, Title: "proposal: table second", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusHold, ChangedAt: changedAt},
+			{IssueNumber: 33333, Title: "proposal: table new", CurrentStatus: parser.StatusActive, ChangedAt: changedAt},
+		},
+	}
+
+	readWeekly := func(t *testing.T, opts ...Option) string {
+		t.Helper()
+		distDir := t.TempDir()
+		gen := NewGenerator(append([]Option{WithDistDir(distDir)}, opts...)...)
+		if err := gen.Generate(context.Background(), []*content.WeeklyContent{week}); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read weekly index: %v", err)
+		}
+		return string(data)
+	}
+
+	t.Run("table layout", func(t *testing.T) {
+		t.Parallel()
+
+		html := readWeekly(t, WithWeeklyLayout(WeeklyLayoutTable))
+
+		if !strings.Contains(html, "<table") {
+			t.Fatal("weekly index should render a table")
+		}
+		if !strings.Contains(html, "2026年 第5週のProposal更新一覧</caption>") {
+			t.Error("table should have a caption")
+		}
+		for _, header := range []string{"Issue", "タイトル", "以前のステータス", "現在のステータス"} {
+			if !strings.Contains(html, `<th scope="col" class="px-4 py-2 font-medium">`+header+`</th>`) {
+				t.Errorf("table should have column header %q", header)
+			}
+		}
+		if got := strings.Count(html, `<th scope="row"`); got != len(week.Proposals) {
+			t.Errorf("table has %d row headers, want %d", got, len(week.Proposals))
+		}
+		for _, p := range week.Proposals {
+			for _, want := range []string{
+				p.Title,
+				fmt.Sprintf(`href="/2026/w05/%d.html"`, p.IssueNumber),
+				fmt.Sprintf(`<tr id="p-%d"`, p.IssueNumber),
+			} {
+				if !strings.Contains(html, want) {
+					t.Errorf("table should contain %q", want)
+				}
+			}
+		}
+		if strings.Contains(html, "<article") {
+			t.Error("table layout should not render proposal cards")
+		}
+	})
+
+	t.Run("cards by default", func(t *testing.T) {
+		t.Parallel()
+
+		html := readWeekly(t)
+		if strings.Contains(html, "<table") {
+			t.Error("default layout should not render a table")
+		}
+		if !strings.Contains(html, "<article") {
+			t.Error("default layout should render proposal cards")
+		}
+	})
+}
+
+// TestIntegration_WeekNavigation verifies that weekly index pages link to the
+// chronologically adjacent weeks, including across a year boundary.
+func TestIntegration_WeekNavigation(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	newWeek := func(year, week, issue int) *content.WeeklyContent {
+		return &content.WeeklyContent{
+			Year: year,
+			Week: week,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: issue, Title: fmt.Sprintf("proposal: %d", issue), CurrentStatus: parser.StatusActive, ChangedAt: changedAt},
+			},
+		}
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir))
+	// Deliberately unsorted input
+	weeks := []*content.WeeklyContent{
+		newWeek(2026, 1, 20001),
+		newWeek(2026, 2, 20002),
+		newWeek(2025, 52, 10052),
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	prevRe := regexp.MustCompile(`<a href="([^"]+)" rel="prev"`)
+	nextRe := regexp.MustCompile(`<a href="([^"]+)" rel="next"`)
+
+	tests := []struct {
+		page     string
+		wantPrev string
+		wantNext string
+	}{
+		{page: filepath.Join("2025", "w52", "index.html"), wantPrev: "", wantNext: "/2026/w01/"},
+		{page: filepath.Join("2026", "w01", "index.html"), wantPrev: "/2025/w52/", wantNext: "/2026/w02/"},
+		{page: filepath.Join("2026", "w02", "index.html"), wantPrev: "/2026/w01/", wantNext: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.page, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join(distDir, tt.page))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", tt.page, err)
+			}
+			html := string(data)
+
+			for _, c := range []struct {
+				re    *regexp.Regexp
+				label string
+				want  string
+			}{
+				{re: prevRe, label: "← 前の週", want: tt.wantPrev},
+				{re: nextRe, label: "次の週 →", want: tt.wantNext},
+			} {
+				m := c.re.FindStringSubmatch(html)
+				switch {
+				case c.want == "" && m != nil:
+					t.Errorf("%s should have no %q link, got href %q", tt.page, c.label, m[1])
+				case c.want == "" && strings.Contains(html, c.label):
+					t.Errorf("%s should not render %q", tt.page, c.label)
+				case c.want != "" && m == nil:
+					t.Errorf("%s should have a %q link", tt.page, c.label)
+				case c.want != "" && m[1] != c.want:
+					t.Errorf("%s %q link = %q, want %q", tt.page, c.label, m[1], c.want)
+				}
+			}
+		})
+	}
+}
+
+// TestIntegration_OGPMetaTags verifies the OpenGraph and Twitter card tags of
+// proposal and weekly pages, including absolute og:url values.
+func TestIntegration_OGPMetaTags(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: add OGP tags",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusLikelyAccept,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+					Summary:        "## 概要\n\n" + strings.Repeat("共有時にプレビューを表示する。", 20),
+				},
+			},
+		},
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	metaContent := func(t *testing.T, html, attr, name string) string {
+		t.Helper()
+		re := regexp.MustCompile(`<meta ` + attr + `="` + regexp.QuoteMeta(name) + `" content="([^"]*)"`)
+		m := re.FindStringSubmatch(html)
+		if m == nil {
+			t.Fatalf("missing <meta %s=%q>", attr, name)
+		}
+		return m[1]
+	}
+
+	t.Run("proposal page", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+		if err != nil {
+			t.Fatalf("failed to read proposal page: %v", err)
+		}
+		html := string(data)
+
+		if got, want := metaContent(t, html, "property", "og:url"), "https://example.com/2026/w05/12345.html"; got != want {
+			t.Errorf("og:url = %q, want %q", got, want)
+		}
+		if got := metaContent(t, html, "property", "og:type"); got != "article" {
+			t.Errorf("og:type = %q, want %q", got, "article")
+		}
+		if got := metaContent(t, html, "property", "og:title"); !strings.Contains(got, "proposal: add OGP tags") {
+			t.Errorf("og:title = %q, want it to contain the proposal title", got)
+		}
+		desc := metaContent(t, html, "property", "og:description")
+		if strings.Contains(desc, "##") || !strings.HasSuffix(desc, "…") {
+			t.Errorf("og:description = %q, want a trimmed plain-text summary", desc)
+		}
+		if n := len([]rune(desc)); n > 120 {
+			t.Errorf("og:description has %d runes, want at most 120", n)
+		}
+		if got := metaContent(t, html, "name", "twitter:card"); got == "" {
+			t.Error("twitter:card should not be empty")
+		}
+		if got := metaContent(t, html, "name", "twitter:description"); got != desc {
+			t.Errorf("twitter:description = %q, want %q", got, desc)
+		}
+	})
+
+	t.Run("weekly index page", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "index.html"))
+		if err != nil {
+			t.Fatalf("failed to read weekly index: %v", err)
+		}
+		html := string(data)
+
+		if got, want := metaContent(t, html, "property", "og:url"), "https://example.com/2026/w05/"; got != want {
+			t.Errorf("og:url = %q, want %q", got, want)
+		}
+		if got := metaContent(t, html, "property", "og:description"); !strings.HasPrefix(got, "2026年 第5週のGo proposal更新") {
+			t.Errorf("og:description = %q, want it to start with %q", got, "2026年 第5週のGo proposal更新")
+		}
+	})
+}
+
+func TestIntegration_CanonicalLink(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: canonical",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		siteURL string
+		want    string
+	}{
+		{name: "absolute URL from site URL", siteURL: "https://example.com", want: `<link rel="canonical" href="https://example.com/2026/w05/12345.html">`},
+		{name: "omitted without site URL", siteURL: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL(tt.siteURL))
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, "2026", "w05", "12345.html"))
+			if err != nil {
+				t.Fatalf("failed to read proposal page: %v", err)
+			}
+			html := string(data)
+			if tt.want == "" {
+				if strings.Contains(html, `rel="canonical"`) {
+					t.Error("proposal page should not have a canonical link without a site URL")
+				}
+				return
+			}
+			if n := strings.Count(html, `rel="canonical"`); n != 1 {
+				t.Errorf("proposal page has %d canonical links, want 1", n)
+			}
+			if !strings.Contains(html, tt.want) {
+				t.Errorf("proposal page should contain %s", tt.want)
+			}
+		})
+	}
+}
+
+// TestIntegration_ArchivePage verifies that the archive page groups weeks
+// under year headings, newest year first, and is listed in the sitemap.
+func TestIntegration_ArchivePage(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	newWeek := func(year, week int, issues ...int) *content.WeeklyContent {
+		w := &content.WeeklyContent{Year: year, Week: week}
+		for _, issue := range issues {
+			w.Proposals = append(w.Proposals, content.ProposalContent{
+				IssueNumber: issue, Title: fmt.Sprintf("proposal: %d", issue), CurrentStatus: parser.StatusActive, ChangedAt: changedAt,
+			})
+		}
+		return w
+	}
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	weeks := []*content.WeeklyContent{
+		newWeek(2025, 51, 10001),
+		newWeek(2026, 2, 20001, 20002, 20003),
+		newWeek(2025, 52, 10002, 10003),
+		newWeek(2026, 1, 20004),
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, "archive", "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read archive page: %v", err)
+	}
+	html := string(data)
+
+	idx2026 := strings.Index(html, `id="y2026"`)
+	idx2025 := strings.Index(html, `id="y2025"`)
+	if idx2026 < 0 || idx2025 < 0 {
+		t.Fatalf("archive should have 2026 and 2025 sections, got 2026=%d 2025=%d", idx2026, idx2025)
+	}
+	if idx2026 > idx2025 {
+		t.Error("2026 section should appear before 2025")
+	}
+
+	section2026, section2025 := html[idx2026:idx2025], html[idx2025:]
+	for _, tt := range []struct {
+		section string
+		want    []string
+	}{
+		{section: section2026, want: []string{"2026年", `href="/2026/w02/"`, `href="/2026/w01/"`, "2週 / 4件"}},
+		{section: section2025, want: []string{"2025年", `href="/2025/w52/"`, `href="/2025/w51/"`, "2週 / 3件"}},
+	} {
+		for _, want := range tt.want {
+			if !strings.Contains(tt.section, want) {
+				t.Errorf("archive section should contain %q", want)
+			}
+		}
+	}
+	if strings.Index(section2026, "/2026/w02/") > strings.Index(section2026, "/2026/w01/") {
+		t.Error("weeks should be listed newest first within a year")
+	}
+	if !strings.Contains(html, `<span class="text-xs text-[var(--text-secondary)]">3件</span>`) {
+		t.Error("archive should show the proposal count of each week")
+	}
+	if !regexp.MustCompile(`<a href="/" class="[^"]*">\s*ホーム\s*</a>`).MatchString(html) {
+		t.Error("archive should link back to home")
+	}
+
+	sitemap, err := os.ReadFile(filepath.Join(distDir, sitemapFilename))
+	if err != nil {
+		t.Fatalf("failed to read sitemap: %v", err)
+	}
+	if !strings.Contains(string(sitemap), "<loc>https://example.com/archive/</loc>") {
+		t.Error("sitemap should list the archive page")
+	}
+}
+
+func TestIntegration_Breadcrumbs(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir))
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 12345, Title: "proposal: breadcrumbs", CurrentStatus: parser.StatusActive, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	anchorRe := regexp.MustCompile(`<a href="([^"]*)"[^>]*>([^<]*)</a>`)
+	// breadcrumbs returns the href and label of each anchor of the trail
+	breadcrumbs := func(t *testing.T, page string) [][2]string {
+		t.Helper()
+		data, err := os.ReadFile(filepath.Join(distDir, page))
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", page, err)
+		}
+		_, trail, found := strings.Cut(string(data), `<nav class="breadcrumbs`)
+		if !found {
+			t.Fatalf("%s should have a breadcrumb trail", page)
+		}
+		trail, _, _ = strings.Cut(trail, "</nav>")
+		var anchors [][2]string
+		for _, m := range anchorRe.FindAllStringSubmatch(trail, -1) {
+			anchors = append(anchors, [2]string{m[1], m[2]})
+		}
+		return anchors
+	}
+
+	tests := []struct {
+		name string
+		page string
+		want [][2]string
+	}{
+		{
+			name: "proposal page",
+			page: filepath.Join("2026", "w05", "12345.html"),
+			want: [][2]string{{"/", "ホーム"}, {"/archive/#y2026", "2026"}, {"/2026/w05/", "第5週"}, {"/2026/w05/12345.html", "#12345"}},
+		},
+		{
+			name: "weekly index",
+			page: filepath.Join("2026", "w05", "index.html"),
+			want: [][2]string{{"/", "ホーム"}, {"/archive/#y2026", "2026"}, {"/2026/w05/", "第5週"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := breadcrumbs(t, tt.page)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("breadcrumbs = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
+)
+
+// jsonFeedFilename is the name of the JSON Feed file.
+const jsonFeedFilename = "feed.json"
+
+// jsonFeedVersion is the version URL of the JSON Feed specification.
+const jsonFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// jsonFeed is a JSON Feed 1.1 document.
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	FeedURL     string           `json:"feed_url"`
+	Description string           `json:"description,omitempty"`
+	Language    string           `json:"language,omitempty"`
+	Authors     []jsonFeedAuthor `json:"authors,omitempty"`
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+// jsonFeedAuthor is the author of a JSON Feed.
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+// jsonFeedItem is a single weekly digest in a JSON Feed.
+type jsonFeedItem struct {
+	ID            string `json:"id"`
+	URL           string `json:"url"`
+	Title         string `json:"title"`
+	ContentHTML   string `json:"content_html"`
+	DatePublished string `json:"date_published,omitempty"`
+	DateModified  string `json:"date_modified,omitempty"`
+}
+
+// GenerateJSONFeed generates a JSON Feed 1.1 document from the given weekly
+// contents. Items match those of GenerateFeed: the most recent weeks, newest
+// first.
+func (fg *FeedGenerator) GenerateJSONFeed(ctx context.Context, weeks []*content.WeeklyContent) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	feed := jsonFeed{
+		Version:     jsonFeedVersion,
+		Title:       fg.siteTitle,
+		HomePageURL: fg.siteURL + "/",
+		FeedURL:     fg.siteURL + templates.DefaultJSONFeedURL,
+		Description: fg.siteDesc,
+		Language:    "ja",
+		Items:       []jsonFeedItem{},
+	}
+	if fg.authorName != "" {
+		feed.Authors = []jsonFeedAuthor{{Name: fg.authorName}}
+	}
+
+	itemTitle, err := fg.parseItemTitle()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, week := range fg.latestWeeks(weeks) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := fg.weekToFeedItem(week)
+		if itemTitle != nil {
+			title, err := fg.renderItemTitle(itemTitle, week)
+			if err != nil {
+				return nil, err
+			}
+			item.Title = title
+		}
+		feed.Items = append(feed.Items, jsonFeedItem{
+			ID:            item.Id,
+			URL:           item.Link.Href,
+			Title:         item.Title,
+			ContentHTML:   item.Description,
+			DatePublished: formatJSONFeedDate(item.Created),
+			DateModified:  formatJSONFeedDate(item.Updated),
+		})
+	}
+
+	data, err := json.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON feed: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// formatJSONFeedDate formats t in RFC 3339 as required by JSON Feed.
+// The zero time yields an empty string so that the date is omitted.
+func formatJSONFeedDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// generateJSONFeed writes the JSON Feed (feed.json).
+// If writing fails, any partially written file is removed.
+func (g *Generator) generateJSONFeed(ctx context.Context, weeks []*content.WeeklyContent) error {
+	fg := g.feedGenerator()
+
+	data, err := fg.GenerateJSONFeed(ctx, weeks)
+	if err != nil {
+		return fmt.Errorf("failed to generate JSON feed: %w", err)
+	}
+
+	feedPath := filepath.Join(g.distDir, jsonFeedFilename)
+	if err := os.WriteFile(feedPath, data, filePerm); err != nil {
+		_ = os.Remove(feedPath)
+		return fmt.Errorf("failed to write %s: %w", jsonFeedFilename, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestFeedGenerator_GenerateJSONFeed(t *testing.T) {
+	t.Parallel()
+
+	// 25 weeks in ascending order, so the cap and ordering are both exercised
+	weeks := make([]*content.WeeklyContent, 0, 25)
+	for i := 1; i <= 25; i++ {
+		weeks = append(weeks, &content.WeeklyContent{
+			Year: 2026,
+			Week: i,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    10000 + i,
+					Title:          fmt.Sprintf("proposal: week %d <feature>", i),
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 7*i),
+				},
+			},
+		})
+	}
+
+	tests := []struct {
+		name      string
+		weeks     []*content.WeeklyContent
+		wantItems int
+	}{
+		{name: "no content yields empty items", weeks: nil, wantItems: 0},
+		{name: "limited to MaxFeedItems newest first", weeks: weeks, wantItems: MaxFeedItems},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fg := NewFeedGenerator(WithSiteURL("https://example.com"))
+			data, err := fg.GenerateJSONFeed(context.Background(), tt.weeks)
+			if err != nil {
+				t.Fatalf("GenerateJSONFeed() error = %v", err)
+			}
+
+			var raw map[string]any
+			if err := json.Unmarshal(data, &raw); err != nil {
+				t.Fatalf("JSON feed is not valid JSON: %v", err)
+			}
+			if _, ok := raw["items"].([]any); !ok {
+				t.Errorf("items should be a JSON array, got %T", raw["items"])
+			}
+
+			var feed jsonFeed
+			if err := json.Unmarshal(data, &feed); err != nil {
+				t.Fatalf("failed to decode JSON feed: %v", err)
+			}
+			if feed.Version != "https://jsonfeed.org/version/1.1" {
+				t.Errorf("version = %q, want JSON Feed 1.1", feed.Version)
+			}
+			if feed.Title == "" {
+				t.Error("title should not be empty")
+			}
+			if feed.HomePageURL != "https://example.com/" {
+				t.Errorf("home_page_url = %q, want %q", feed.HomePageURL, "https://example.com/")
+			}
+			if feed.FeedURL != "https://example.com/feed.json" {
+				t.Errorf("feed_url = %q, want %q", feed.FeedURL, "https://example.com/feed.json")
+			}
+			if len(feed.Items) != tt.wantItems {
+				t.Fatalf("got %d items, want %d", len(feed.Items), tt.wantItems)
+			}
+
+			for i, item := range feed.Items {
+				if item.ID == "" || item.URL == "" || item.Title == "" || item.ContentHTML == "" {
+					t.Errorf("item %d has empty required fields: %+v", i, item)
+				}
+				if _, err := time.Parse(time.RFC3339, item.DatePublished); err != nil {
+					t.Errorf("item %d date_published = %q is not RFC 3339: %v", i, item.DatePublished, err)
+				}
+			}
+			if tt.wantItems > 0 {
+				if got, want := feed.Items[0].URL, "https://example.com/2026/w25/"; got != want {
+					t.Errorf("first item url = %q, want newest week %q", got, want)
+				}
+				if got, want := feed.Items[len(feed.Items)-1].URL, "https://example.com/2026/w06/"; got != want {
+					t.Errorf("last item url = %q, want %q", got, want)
+				}
+				if !strings.Contains(feed.Items[0].ContentHTML, "&lt;feature&gt;") {
+					t.Errorf("content_html should escape titles, got %q", feed.Items[0].ContentHTML)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_GenerateJSONFeed(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   12345,
+					Title:         "proposal: json feed",
+					CurrentStatus: parser.StatusActive,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+	if err := gen.Generate(context.Background(), weeks); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, jsonFeedFilename))
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", jsonFeedFilename, err)
+	}
+	var feed jsonFeed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("%s is not valid JSON: %v", jsonFeedFilename, err)
+	}
+	if len(feed.Items) != 1 {
+		t.Errorf("got %d items, want 1", len(feed.Items))
+	}
+
+	html, err := os.ReadFile(filepath.Join(distDir, "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read index.html: %v", err)
+	}
+	if !strings.Contains(string(html), `<link rel="alternate" type="application/feed+json"`) {
+		t.Error("index.html should include JSON Feed autodiscovery link")
+	}
+}
@@ -0,0 +1,104 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/a-h/templ"
+	"golang.org/x/net/html"
+)
+
+// preservedElements are elements whose text content is written verbatim by
+// minifyHTML because whitespace in them is significant or not HTML text.
+var preservedElements = map[string]bool{
+	"pre":      true,
+	"code":     true,
+	"textarea": true,
+	"script":   true,
+	"style":    true,
+}
+
+// minifyHTML removes comments from page and collapses each run of
+// whitespace in text to a single space, except inside preservedElements.
+// Tags, the doctype and attribute values are written as they are.
+func minifyHTML(page []byte) ([]byte, error) {
+	var out bytes.Buffer
+	out.Grow(len(page))
+
+	z := html.NewTokenizer(bytes.NewReader(page))
+	// Depth of open preserved elements; text is kept verbatim while positive
+	preserved := 0
+	for {
+		tt := z.Next()
+		// TagName may modify the raw token in place, so copy it first
+		raw := slices.Clone(z.Raw())
+		switch tt {
+		case html.ErrorToken:
+			if err := z.Err(); !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return out.Bytes(), nil
+		case html.CommentToken:
+			continue
+		case html.StartTagToken:
+			if name, _ := z.TagName(); preservedElements[string(name)] {
+				preserved++
+			}
+		case html.EndTagToken:
+			if name, _ := z.TagName(); preservedElements[string(name)] && preserved > 0 {
+				preserved--
+			}
+		case html.TextToken:
+			if preserved == 0 {
+				out.WriteString(collapseWhitespace(string(raw)))
+				continue
+			}
+		}
+		out.Write(raw)
+	}
+}
+
+// collapseWhitespace replaces each run of HTML whitespace in s with a
+// single space.
+func collapseWhitespace(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+	inSpace := false
+	for _, r := range s {
+		if strings.ContainsRune(" \t\n\f\r", r) {
+			if !inSpace {
+				sb.WriteByte(' ')
+			}
+			inSpace = true
+			continue
+		}
+		inSpace = false
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// withMinify returns component with its output passed through minifyHTML
+// when WithMinify is set.
+func (g *Generator) withMinify(component templ.Component) templ.Component {
+	if !g.minify {
+		return component
+	}
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		var buf bytes.Buffer
+		if err := component.Render(ctx, &buf); err != nil {
+			return err
+		}
+
+		page, err := minifyHTML(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(page)
+		return err
+	})
+}
@@ -0,0 +1,132 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestMinifyHTML(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "collapses whitespace between and inside elements",
+			input: "<div>\n\t\t<p>a   b\n c</p>\n</div>",
+			want:  "<div> <p>a b c</p> </div>",
+		},
+		{
+			name:  "strips comments",
+			input: "<head><!-- Open Graph Protocol -->\n<title>t</title></head>",
+			want:  "<head> <title>t</title></head>",
+		},
+		{
+			name:  "keeps pre and code content",
+			input: "<pre><code>func main() {\n\tfmt.Println(\"a  b\")\n}</code></pre>\n\n<p>x</p>",
+			want:  "<pre><code>func main() {\n\tfmt.Println(\"a  b\")\n}</code></pre> <p>x</p>",
+		},
+		{
+			name:  "keeps script and style content",
+			input: "<style>\n  a { color: red; }\n</style><script>\n  var a  = 1;\n</script>",
+			want:  "<style>\n  a { color: red; }\n</style><script>\n  var a  = 1;\n</script>",
+		},
+		{
+			name:  "keeps doctype and attributes",
+			input: "<!DOCTYPE html>\n<html lang=\"ja\"><a title=\"a  b\" href=\"/x\">y</a></html>",
+			want:  "<!DOCTYPE html> <html lang=\"ja\"><a title=\"a  b\" href=\"/x\">y</a></html>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := minifyHTML([]byte(tt.input))
+			if err != nil {
+				t.Fatalf("minifyHTML() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("minifyHTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerator_Minify(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   12345,
+					Title:         "proposal: minify test",
+					CurrentStatus: parser.StatusAccepted,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+					Summary:       "## 概要\n\n要約です。",
+					FullContent:   "## 概要\n\n要約です。\n\n```go\nfunc main() {\n\tprintln(\"a  b\")\n}\n```",
+				},
+			},
+		},
+	}
+
+	generate := func(t *testing.T, opts ...Option) string {
+		t.Helper()
+		distDir := t.TempDir()
+		gen := NewGenerator(append([]Option{WithDistDir(distDir)}, opts...)...)
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		return distDir
+	}
+	plainDir := generate(t)
+	minifiedDir := generate(t, WithMinify(true))
+
+	plain, err := os.ReadFile(filepath.Join(plainDir, "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read index.html: %v", err)
+	}
+	minified, err := os.ReadFile(filepath.Join(minifiedDir, "index.html"))
+	if err != nil {
+		t.Fatalf("failed to read minified index.html: %v", err)
+	}
+	if len(minified) >= len(plain) {
+		t.Errorf("minified index.html is %d bytes, want less than %d", len(minified), len(plain))
+	}
+
+	html := string(minified)
+	if !strings.HasPrefix(html, "<!DOCTYPE html>") && !strings.HasPrefix(html, "<!doctype html>") {
+		t.Error("minified index.html should start with HTML5 doctype")
+	}
+	for _, elem := range []string{"<html", `lang="ja"`, "<head>", `<meta charset="UTF-8"`, "<title>", "</head>", "<body", "</body>", "</html>"} {
+		if !strings.Contains(html, elem) {
+			t.Errorf("minified index.html missing required element: %s", elem)
+		}
+	}
+	if strings.Contains(html, "<!--") {
+		t.Error("minified index.html should not contain comments")
+	}
+
+	// Code blocks on proposal pages keep their whitespace
+	proposal, err := os.ReadFile(filepath.Join(minifiedDir, "2026", "w05", "12345.html"))
+	if err != nil {
+		t.Fatalf("failed to read proposal page: %v", err)
+	}
+	_, code, _ := strings.Cut(string(proposal), "<pre")
+	code, _, _ = strings.Cut(code, "</pre>")
+	if !strings.Contains(code, "\n") || !strings.Contains(code, "\t") {
+		t.Errorf("minified proposal page should keep whitespace inside code blocks, got %q", code)
+	}
+}
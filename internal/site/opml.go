@@ -0,0 +1,75 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
+)
+
+// opmlFilename is the name of the OPML file listing the generated feeds.
+const opmlFilename = "feeds.opml"
+
+// opmlDocument is the root element of an OPML 2.0 document.
+type opmlDocument struct {
+	XMLName xml.Name      `xml:"opml"`
+	Version string        `xml:"version,attr"`
+	Title   string        `xml:"head>title"`
+	Outline []opmlOutline `xml:"body>outline"`
+}
+
+// opmlOutline is a single feed subscription in an OPML document.
+type opmlOutline struct {
+	Type    string `xml:"type,attr"`
+	Text    string `xml:"text,attr"`
+	Title   string `xml:"title,attr"`
+	XMLURL  string `xml:"xmlUrl,attr"`
+	HTMLURL string `xml:"htmlUrl,attr"`
+}
+
+// buildOPML builds an OPML document subscribing to feeds, the feeds
+// produced by Generate as listed by feedLinks.
+func (g *Generator) buildOPML(feeds []templates.FeedLink) ([]byte, error) {
+	doc := opmlDocument{
+		Version: "2.0",
+		Title:   g.siteTitle,
+	}
+	for _, feed := range feeds {
+		title := fmt.Sprintf("%s (%s)", g.siteTitle, feed.Title)
+		doc.Outline = append(doc.Outline, opmlOutline{
+			Type:    "rss",
+			Text:    title,
+			Title:   title,
+			XMLURL:  feed.URL,
+			HTMLURL: g.siteURL + "/",
+		})
+	}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OPML: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// generateOPML writes feeds.opml listing feeds.
+func (g *Generator) generateOPML(ctx context.Context, feeds []templates.FeedLink) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := g.buildOPML(feeds)
+	if err != nil {
+		return err
+	}
+
+	opmlPath := filepath.Join(g.distDir, opmlFilename)
+	if err := os.WriteFile(opmlPath, data, filePerm); err != nil {
+		_ = os.Remove(opmlPath)
+		return fmt.Errorf("failed to write %s: %w", opmlFilename, err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_GenerateOPML(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: opml test",
+					Category:       "net/http",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		categories bool
+		wantURLs   []string
+	}{
+		{name: "lists the RSS feed when enabled", enabled: true, wantURLs: []string{"https://example.com/feed.xml"}},
+		{
+			name:       "lists category feeds with categories",
+			enabled:    true,
+			categories: true,
+			wantURLs:   []string{"https://example.com/feed.xml", "https://example.com/feed-net-http.xml"},
+		},
+		{name: "not generated by default", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+				WithOPML(tt.enabled),
+				WithCategoryPages(tt.categories),
+			)
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, opmlFilename))
+			if !tt.enabled {
+				if !errors.Is(err, os.ErrNotExist) {
+					t.Errorf("%s should not exist, got err = %v", opmlFilename, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", opmlFilename, err)
+			}
+
+			var doc opmlDocument
+			if err := xml.Unmarshal(data, &doc); err != nil {
+				t.Fatalf("failed to parse %s: %v", opmlFilename, err)
+			}
+			if doc.Version != "2.0" {
+				t.Errorf("opml version = %q, want %q", doc.Version, "2.0")
+			}
+
+			outlines := make(map[string]opmlOutline)
+			for _, o := range doc.Outline {
+				outlines[o.XMLURL] = o
+			}
+			for _, wantURL := range tt.wantURLs {
+				o, ok := outlines[wantURL]
+				if !ok {
+					t.Errorf("%s has no <outline> with xmlUrl %q:\n%s", opmlFilename, wantURL, data)
+					continue
+				}
+				if o.Type != "rss" {
+					t.Errorf("outline type = %q, want %q", o.Type, "rss")
+				}
+				if o.Title == "" {
+					t.Error("outline title should not be empty")
+				}
+			}
+			if _, ok := outlines["https://example.com/feed-net-http.xml"]; ok != tt.categories {
+				t.Errorf("%s lists the category feed = %v, want %v", opmlFilename, ok, tt.categories)
+			}
+			for url := range outlines {
+				name := strings.TrimPrefix(url, "https://example.com/")
+				if _, err := os.Stat(filepath.Join(distDir, name)); err != nil {
+					t.Errorf("%s lists %s, which was not generated: %v", opmlFilename, url, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/a-h/templ"
+)
+
+// Override template filenames looked up in the directory given to
+// WithTemplateDir. Each is an html/template executed with the same data the
+// built-in page receives:
+//
+//   - home.html: templates.HomeData
+//   - weekly.html: templates.WeeklyData
+//   - proposal.html: templates.ProposalDetailData
+//
+// The override renders the whole page, including <html> and <head>.
+const (
+	HomeTemplateFile     = "home.html"
+	WeeklyTemplateFile   = "weekly.html"
+	ProposalTemplateFile = "proposal.html"
+)
+
+// overrideTemplateFiles lists the pages that can be overridden.
+var overrideTemplateFiles = []string{HomeTemplateFile, WeeklyTemplateFile, ProposalTemplateFile}
+
+// loadOverrideTemplates parses the override templates present in dir.
+// Missing files are skipped so that the built-in page is used instead.
+func loadOverrideTemplates(dir string) (map[string]*template.Template, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to access template directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("template directory %s is not a directory", dir)
+	}
+
+	overrides := make(map[string]*template.Template)
+	for _, name := range overrideTemplateFiles {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+		}
+
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+		}
+		overrides[name] = tmpl
+	}
+	return overrides, nil
+}
+
+// pageComponent returns the override template for name executed with data,
+// or fallback if the page is not overridden.
+func (g *Generator) pageComponent(name string, data any, fallback templ.Component) templ.Component {
+	tmpl, ok := g.overrides[name]
+	if !ok {
+		return fallback
+	}
+	return templ.ComponentFunc(func(_ context.Context, w io.Writer) error {
+		if err := tmpl.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to execute template %s: %w", name, err)
+		}
+		return nil
+	})
+}
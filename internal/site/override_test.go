@@ -0,0 +1,118 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_TemplateDir(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{IssueNumber: 12345, Title: "proposal: <override>", PreviousStatus: parser.StatusActive, CurrentStatus: parser.StatusAccepted, ChangedAt: time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	writeTemplates := func(t *testing.T, files map[string]string) string {
+		t.Helper()
+		dir := t.TempDir()
+		for name, body := range files {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644); err != nil {
+				t.Fatalf("failed to write %s: %v", name, err)
+			}
+		}
+		return dir
+	}
+
+	readFile := func(t *testing.T, path string) string {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", path, err)
+		}
+		return string(data)
+	}
+
+	t.Run("home override changes the title", func(t *testing.T) {
+		t.Parallel()
+
+		tmplDir := writeTemplates(t, map[string]string{
+			HomeTemplateFile: `<html><head><title>Custom Digest</title></head><body>{{range .Weeks}}<a href="{{.URL}}">{{.ProposalCount}}</a>{{end}}</body></html>`,
+		})
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithTemplateDir(tmplDir))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		home := readFile(t, filepath.Join(distDir, "index.html"))
+		if !strings.Contains(home, "<title>Custom Digest</title>") {
+			t.Errorf("home page should use the override title, got:\n%s", home)
+		}
+		if !strings.Contains(home, `<a href="/2026/w05/">1</a>`) {
+			t.Errorf("home override should receive HomeData, got:\n%s", home)
+		}
+
+		// Pages without an override keep the built-in template
+		weekly := readFile(t, filepath.Join(distDir, "2026", "w05", "index.html"))
+		if !strings.Contains(weekly, "2026年 第5週") {
+			t.Error("weekly page should fall back to the built-in template")
+		}
+	})
+
+	t.Run("proposal override escapes data", func(t *testing.T) {
+		t.Parallel()
+
+		tmplDir := writeTemplates(t, map[string]string{
+			ProposalTemplateFile: `<h1>#{{.IssueNumber}} {{.Title}}</h1>`,
+		})
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithTemplateDir(tmplDir))
+		if err := gen.Generate(context.Background(), weeks); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		got := readFile(t, filepath.Join(distDir, "2026", "w05", "12345.html"))
+		if want := "<h1>#12345 proposal: &lt;override&gt;</h1>"; got != want {
+			t.Errorf("proposal page = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("invalid template fails before writing", func(t *testing.T) {
+		t.Parallel()
+
+		tmplDir := writeTemplates(t, map[string]string{
+			WeeklyTemplateFile: `{{.Year`,
+		})
+		distDir := t.TempDir()
+		gen := NewGenerator(WithDistDir(distDir), WithTemplateDir(tmplDir))
+		err := gen.Generate(context.Background(), weeks)
+		if err == nil || !strings.Contains(err.Error(), WeeklyTemplateFile) {
+			t.Fatalf("Generate() error = %v, want parse error for %s", err, WeeklyTemplateFile)
+		}
+		if _, err := os.Stat(filepath.Join(distDir, "index.html")); !os.IsNotExist(err) {
+			t.Errorf("no page should be written on template error, stat err = %v", err)
+		}
+	})
+
+	t.Run("missing directory is an error", func(t *testing.T) {
+		t.Parallel()
+
+		gen := NewGenerator(WithDistDir(t.TempDir()), WithTemplateDir(filepath.Join(t.TempDir(), "missing")))
+		if err := gen.Generate(context.Background(), weeks); err == nil {
+			t.Fatal("Generate() should fail for a missing template directory")
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// robotsFilename is the name of the robots.txt file written at the site root.
+const robotsFilename = "robots.txt"
+
+// noIndexMeta is injected into the head of every page when WithNoIndex is set.
+const noIndexMeta = `<meta name="robots" content="noindex"/>`
+
+// buildRobotsTxt builds robots.txt. It allows all crawlers and points at the
+// sitemap, or disallows everything when noindex is set. The sitemap line is
+// only written when the site URL is known, since it must be absolute.
+func (g *Generator) buildRobotsTxt() []byte {
+	var sb strings.Builder
+
+	sb.WriteString("User-agent: *\n")
+	if g.noIndex {
+		sb.WriteString("Disallow: /\n")
+		return []byte(sb.String())
+	}
+	sb.WriteString("Allow: /\n")
+	if g.siteURL != "" {
+		fmt.Fprintf(&sb, "\nSitemap: %s/%s\n", g.siteURL, sitemapFilename)
+	}
+
+	return []byte(sb.String())
+}
+
+// generateRobotsTxt writes robots.txt.
+func (g *Generator) generateRobotsTxt(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	robotsPath := filepath.Join(g.distDir, robotsFilename)
+	if err := os.WriteFile(robotsPath, g.buildRobotsTxt(), filePerm); err != nil {
+		_ = os.Remove(robotsPath)
+		return fmt.Errorf("failed to write %s: %w", robotsFilename, err)
+	}
+	return nil
+}
+
+// withNoIndexMeta returns component with noIndexMeta injected right after
+// the opening <head> tag when WithNoIndex is set. Pages that already carry a
+// robots meta tag (noindex aggregates) are left unchanged. This covers
+// override templates as well as the built-in layouts.
+func (g *Generator) withNoIndexMeta(component templ.Component) templ.Component {
+	if !g.noIndex {
+		return component
+	}
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		var buf bytes.Buffer
+		if err := component.Render(ctx, &buf); err != nil {
+			return err
+		}
+
+		page := buf.Bytes()
+		if !bytes.Contains(page, []byte(`<meta name="robots"`)) {
+			if i := bytes.Index(page, []byte("<head>")); i >= 0 {
+				i += len("<head>")
+				page = slices.Concat(page[:i], []byte(noIndexMeta), page[i:])
+			}
+		}
+		_, err := w.Write(page)
+		return err
+	})
+}
@@ -0,0 +1,90 @@
+package site
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_GenerateRobotsTxt(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:   12345,
+					Title:         "proposal: robots test",
+					CurrentStatus: parser.StatusAccepted,
+					ChangedAt:     time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		opts       []Option
+		wantRobots string
+		wantMeta   bool
+	}{
+		{
+			name:       "allows all and points at the sitemap by default",
+			wantRobots: "User-agent: *\nAllow: /\n\nSitemap: https://example.com/sitemap.xml\n",
+		},
+		{
+			name:       "disallows all and marks every page noindex",
+			opts:       []Option{WithNoIndex(true)},
+			wantRobots: "User-agent: *\nDisallow: /\n",
+			wantMeta:   true,
+		},
+		{
+			name:       "noindex also applies to incremental rendering",
+			opts:       []Option{WithNoIndex(true), WithIncremental(true)},
+			wantRobots: "User-agent: *\nDisallow: /\n",
+			wantMeta:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(append([]Option{
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+			}, tt.opts...)...)
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			robots, err := os.ReadFile(filepath.Join(distDir, robotsFilename))
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", robotsFilename, err)
+			}
+			if string(robots) != tt.wantRobots {
+				t.Errorf("%s = %q, want %q", robotsFilename, robots, tt.wantRobots)
+			}
+
+			for _, page := range []string{"index.html", filepath.Join("2026", "w05", "index.html"), filepath.Join("2026", "w05", "12345.html")} {
+				html, err := os.ReadFile(filepath.Join(distDir, page))
+				if err != nil {
+					t.Fatalf("failed to read %s: %v", page, err)
+				}
+				head, _, _ := strings.Cut(string(html), "</head>")
+				if got := strings.Contains(head, noIndexMeta); got != tt.wantMeta {
+					t.Errorf("%s head contains noindex meta = %v, want %v", page, got, tt.wantMeta)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
+)
+
+// sitemapFilename is the name of the sitemap file.
+const sitemapFilename = "sitemap.xml"
+
+// sitemapNamespace is the XML namespace of the sitemap protocol.
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// sitemapURLSet is the root element of a sitemap.
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+// sitemapURL is a single sitemap entry.
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// BuildSitemap builds a sitemap listing the home page, each weekly index and
+// each proposal page with absolute URLs under siteURL.
+// lastmod is taken from ChangedAt, or from ProposalContent.LastModified when
+// useUpdatedAt is true, so that later summary/link edits are reflected.
+// Index pages use the latest lastmod of the proposals they list, falling back
+// to the week's CreatedAt when none of them has a date.
+// Without any content the sitemap lists only the home page.
+func BuildSitemap(siteURL string, weeks []*content.WeeklyContent, useUpdatedAt bool) ([]byte, error) {
+	return buildSitemap(siteURL, weeks, useUpdatedAt, nil)
+}
+
+// buildSitemap builds the sitemap like BuildSitemap, appending the given
+// aggregate page entries after the weekly and proposal pages.
+func buildSitemap(siteURL string, weeks []*content.WeeklyContent, useUpdatedAt bool, aggregates []sitemapURL) ([]byte, error) {
+	modTime := func(p content.ProposalContent) time.Time {
+		if useUpdatedAt {
+			return p.LastModified()
+		}
+		return p.ChangedAt
+	}
+
+	var siteLastMod time.Time
+	var urls []sitemapURL
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+
+		var weekLastMod time.Time
+		proposalURLs := make([]sitemapURL, 0, len(week.Proposals))
+		for _, p := range week.Proposals {
+			mod := modTime(p)
+			if mod.After(weekLastMod) {
+				weekLastMod = mod
+			}
+			proposalURLs = append(proposalURLs, sitemapURL{
+				Loc:     fmt.Sprintf("%s/%d/w%02d/%s", siteURL, week.Year, week.Week, proposalFilename(p.IssueNumber)),
+				LastMod: formatLastMod(mod),
+			})
+		}
+		if weekLastMod.IsZero() {
+			weekLastMod = week.CreatedAt
+		}
+		if weekLastMod.After(siteLastMod) {
+			siteLastMod = weekLastMod
+		}
+
+		urls = append(urls, sitemapURL{
+			Loc:     fmt.Sprintf("%s/%d/w%02d/", siteURL, week.Year, week.Week),
+			LastMod: formatLastMod(weekLastMod),
+		})
+		urls = append(urls, proposalURLs...)
+	}
+
+	home := sitemapURL{Loc: siteURL + "/", LastMod: formatLastMod(siteLastMod)}
+	set := sitemapURLSet{
+		Xmlns: sitemapNamespace,
+		URLs:  append(append([]sitemapURL{home}, urls...), aggregates...),
+	}
+
+	data, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sitemap: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// formatLastMod formats t in the W3C datetime format used by sitemaps.
+// The zero time yields an empty string so that lastmod is omitted.
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// latestChangedAt returns the latest ChangedAt of all proposals, the lastmod
+// of the pages built from every week.
+func latestChangedAt(weeks []*content.WeeklyContent) time.Time {
+	var lastMod time.Time
+	for _, week := range weeks {
+		if week == nil {
+			continue
+		}
+		for _, p := range week.Proposals {
+			if p.ChangedAt.After(lastMod) {
+				lastMod = p.ChangedAt
+			}
+		}
+	}
+	return lastMod
+}
+
+// monthlySitemapURLs returns sitemap entries for the monthly rollup pages.
+// lastmod is the latest ChangedAt of the proposals listed on each page.
+func monthlySitemapURLs(siteURL string, months []templates.MonthlyData) []sitemapURL {
+	urls := make([]sitemapURL, 0, len(months))
+	for _, month := range months {
+		var lastMod time.Time
+		for _, p := range month.Proposals {
+			if p.ChangedAt.After(lastMod) {
+				lastMod = p.ChangedAt
+			}
+		}
+		urls = append(urls, sitemapURL{
+			Loc:     siteURL + templates.MonthlyURL(month.Year, month.Month),
+			LastMod: formatLastMod(lastMod),
+		})
+	}
+	return urls
+}
+
+// yearReviewSitemapURLs returns sitemap entries for the year in review pages.
+// lastmod is the latest ChangedAt of the proposals summarized on each page.
+func yearReviewSitemapURLs(siteURL string, reviews []templates.YearReviewData) []sitemapURL {
+	urls := make([]sitemapURL, 0, len(reviews))
+	for _, review := range reviews {
+		urls = append(urls, sitemapURL{
+			Loc:     siteURL + templates.YearReviewURL(review.Year),
+			LastMod: formatLastMod(review.LastChangedAt),
+		})
+	}
+	return urls
+}
+
+// categorySitemapURLs returns sitemap entries for the category pages.
+// lastmod is the latest ChangedAt of the proposals listed on each page.
+func categorySitemapURLs(siteURL string, categories []templates.CategoryData) []sitemapURL {
+	urls := make([]sitemapURL, 0, len(categories))
+	for _, category := range categories {
+		var lastMod time.Time
+		for _, p := range category.Proposals {
+			if p.ChangedAt.After(lastMod) {
+				lastMod = p.ChangedAt
+			}
+		}
+		urls = append(urls, sitemapURL{
+			Loc:     siteURL + templates.CategoryURL(category.Name),
+			LastMod: formatLastMod(lastMod),
+		})
+	}
+	return urls
+}
+
+// generateSitemap writes sitemap.xml.
+// The archive page is always listed; the aggregate pages are listed unless
+// they are marked noindex.
+func (g *Generator) generateSitemap(ctx context.Context, weeks []*content.WeeklyContent, months []templates.MonthlyData, reviews []templates.YearReviewData, categories []templates.CategoryData) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	lastMod := formatLastMod(latestChangedAt(weeks))
+	aggregates := []sitemapURL{{Loc: g.siteURL + templates.ArchiveURL, LastMod: lastMod}}
+	if !g.noIndexAggregate {
+		aggregates = append(aggregates, sitemapURL{Loc: g.siteURL + templates.StatsURL, LastMod: lastMod})
+		aggregates = append(aggregates, monthlySitemapURLs(g.siteURL, months)...)
+		aggregates = append(aggregates, yearReviewSitemapURLs(g.siteURL, reviews)...)
+		aggregates = append(aggregates, categorySitemapURLs(g.siteURL, categories)...)
+	}
+
+	data, err := buildSitemap(g.siteURL, weeks, g.useUpdatedAt, aggregates)
+	if err != nil {
+		return err
+	}
+
+	sitemapPath := filepath.Join(g.distDir, sitemapFilename)
+	if err := os.WriteFile(sitemapPath, data, filePerm); err != nil {
+		_ = os.Remove(sitemapPath)
+		return fmt.Errorf("failed to write %s: %w", sitemapFilename, err)
+	}
+	return nil
+}
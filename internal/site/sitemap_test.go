@@ -0,0 +1,307 @@
+package site
+
+import (
+	"context"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+)
+
+func TestGenerator_SitemapLastModFromUpdatedAt(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	editedAt := time.Date(2026, 2, 3, 9, 30, 0, 0, time.UTC)
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: edited later",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusLikelyAccept,
+					ChangedAt:      changedAt,
+					UpdatedAt:      editedAt,
+					Summary:        "summary edited after the status change",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		want         time.Time
+		name         string
+		useUpdatedAt bool
+	}{
+		{name: "prefers updated_at when enabled", useUpdatedAt: true, want: editedAt},
+		{name: "uses changed_at by default", useUpdatedAt: false, want: changedAt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+				WithUpdatedAtDates(tt.useUpdatedAt),
+			)
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, "sitemap.xml"))
+			if err != nil {
+				t.Fatalf("failed to read sitemap.xml: %v", err)
+			}
+
+			var set sitemapURLSet
+			if err := xml.Unmarshal(data, &set); err != nil {
+				t.Fatalf("sitemap.xml is not valid XML: %v", err)
+			}
+
+			lastMods := make(map[string]string)
+			for _, u := range set.URLs {
+				lastMods[u.Loc] = u.LastMod
+			}
+
+			want := tt.want.Format(time.RFC3339)
+			for _, loc := range []string{
+				"https://example.com/",
+				"https://example.com/2026/w05/",
+				"https://example.com/2026/w05/12345.html",
+			} {
+				got, ok := lastMods[loc]
+				if !ok {
+					t.Errorf("sitemap should list %s", loc)
+					continue
+				}
+				if got != want {
+					t.Errorf("lastmod for %s = %q, want %q", loc, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildSitemap(t *testing.T) {
+	t.Parallel()
+
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 2, 4, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		wantLastMod map[string]string
+		name        string
+		weeks       []*content.WeeklyContent
+	}{
+		{
+			name:        "no content lists only the home page",
+			weeks:       nil,
+			wantLastMod: map[string]string{"https://example.com/": ""},
+		},
+		{
+			name: "index page falls back to week CreatedAt",
+			weeks: []*content.WeeklyContent{
+				{
+					Year:      2026,
+					Week:      6,
+					CreatedAt: createdAt,
+					Proposals: []content.ProposalContent{{IssueNumber: 1, Title: "proposal: undated"}},
+				},
+			},
+			wantLastMod: map[string]string{
+				"https://example.com/":                createdAt.Format(time.RFC3339),
+				"https://example.com/2026/w06/":       createdAt.Format(time.RFC3339),
+				"https://example.com/2026/w06/1.html": "",
+			},
+		},
+		{
+			name: "proposal ChangedAt takes precedence over CreatedAt",
+			weeks: []*content.WeeklyContent{
+				{
+					Year:      2026,
+					Week:      5,
+					CreatedAt: createdAt,
+					Proposals: []content.ProposalContent{{IssueNumber: 2, Title: "proposal: dated", ChangedAt: changedAt}},
+				},
+			},
+			wantLastMod: map[string]string{
+				"https://example.com/":                changedAt.Format(time.RFC3339),
+				"https://example.com/2026/w05/":       changedAt.Format(time.RFC3339),
+				"https://example.com/2026/w05/2.html": changedAt.Format(time.RFC3339),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := BuildSitemap("https://example.com", tt.weeks, false)
+			if err != nil {
+				t.Fatalf("BuildSitemap() error = %v", err)
+			}
+
+			var set sitemapURLSet
+			if err := xml.Unmarshal(data, &set); err != nil {
+				t.Fatalf("sitemap is not valid XML: %v", err)
+			}
+			if set.Xmlns != sitemapNamespace {
+				t.Errorf("xmlns = %q, want %q", set.Xmlns, sitemapNamespace)
+			}
+
+			got := make(map[string]string, len(set.URLs))
+			for _, u := range set.URLs {
+				got[u.Loc] = u.LastMod
+			}
+			if len(got) != len(tt.wantLastMod) {
+				t.Errorf("sitemap lists %d URLs, want %d: %v", len(got), len(tt.wantLastMod), got)
+			}
+			for loc, want := range tt.wantLastMod {
+				lastMod, ok := got[loc]
+				if !ok {
+					t.Errorf("sitemap should list %s", loc)
+					continue
+				}
+				if lastMod != want {
+					t.Errorf("lastmod for %s = %q, want %q", loc, lastMod, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_SitemapWithoutContent(t *testing.T) {
+	t.Parallel()
+
+	distDir := t.TempDir()
+	gen := NewGenerator(WithDistDir(distDir), WithGeneratorSiteURL("https://example.com"))
+	if err := gen.Generate(context.Background(), nil); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(distDir, sitemapFilename))
+	if err != nil {
+		t.Fatalf("failed to read sitemap.xml: %v", err)
+	}
+	var set sitemapURLSet
+	if err := xml.Unmarshal(data, &set); err != nil {
+		t.Fatalf("sitemap.xml is not valid XML: %v", err)
+	}
+	var locs []string
+	for _, u := range set.URLs {
+		locs = append(locs, u.Loc)
+	}
+	want := []string{"https://example.com/", "https://example.com/archive/", "https://example.com/stats/"}
+	if !slices.Equal(locs, want) {
+		t.Errorf("sitemap URLs = %v, want %v", locs, want)
+	}
+}
+
+func TestGenerator_NoIndexAggregates(t *testing.T) {
+	t.Parallel()
+
+	weeks := []*content.WeeklyContent{
+		{
+			Year: 2026,
+			Week: 5,
+			Proposals: []content.ProposalContent{
+				{
+					IssueNumber:    12345,
+					Title:          "proposal: indexed",
+					PreviousStatus: parser.StatusActive,
+					CurrentStatus:  parser.StatusAccepted,
+					ChangedAt:      time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+	}
+
+	const (
+		robotsMeta   = `<meta name="robots" content="noindex,follow">`
+		monthlyURL   = "https://example.com/2026/01/"
+		statsURL     = "https://example.com/stats/"
+		proposalURL  = "https://example.com/2026/w05/12345.html"
+		monthlyPage  = "2026/01/index.html"
+		statsPage    = "stats/index.html"
+		proposalPage = "2026/w05/12345.html"
+	)
+
+	tests := []struct {
+		name       string
+		noIndex    bool
+		wantListed bool
+	}{
+		{name: "aggregates are noindexed and omitted from sitemap", noIndex: true, wantListed: false},
+		{name: "aggregates are indexable by default", noIndex: false, wantListed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			distDir := t.TempDir()
+			gen := NewGenerator(
+				WithDistDir(distDir),
+				WithGeneratorSiteURL("https://example.com"),
+				WithMonthlyPages(true),
+				WithNoIndexAggregates(tt.noIndex),
+			)
+			if err := gen.Generate(context.Background(), weeks); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			readPage := func(rel string) string {
+				t.Helper()
+				data, err := os.ReadFile(filepath.Join(distDir, filepath.FromSlash(rel)))
+				if err != nil {
+					t.Fatalf("failed to read %s: %v", rel, err)
+				}
+				return string(data)
+			}
+
+			if got := strings.Contains(readPage(monthlyPage), robotsMeta); got != tt.noIndex {
+				t.Errorf("monthly page has robots noindex = %v, want %v", got, tt.noIndex)
+			}
+			if got := strings.Contains(readPage(statsPage), robotsMeta); got != tt.noIndex {
+				t.Errorf("stats page has robots noindex = %v, want %v", got, tt.noIndex)
+			}
+			if strings.Contains(readPage(proposalPage), `name="robots"`) {
+				t.Error("proposal page should stay indexable")
+			}
+
+			data, err := os.ReadFile(filepath.Join(distDir, "sitemap.xml"))
+			if err != nil {
+				t.Fatalf("failed to read sitemap.xml: %v", err)
+			}
+			var set sitemapURLSet
+			if err := xml.Unmarshal(data, &set); err != nil {
+				t.Fatalf("sitemap.xml is not valid XML: %v", err)
+			}
+			locs := make(map[string]bool)
+			for _, u := range set.URLs {
+				locs[u.Loc] = true
+			}
+			if !locs[proposalURL] {
+				t.Errorf("sitemap should list %s", proposalURL)
+			}
+			for _, loc := range []string{monthlyURL, statsURL} {
+				if locs[loc] != tt.wantListed {
+					t.Errorf("sitemap lists %s = %v, want %v", loc, locs[loc], tt.wantListed)
+				}
+			}
+		})
+	}
+}
@@ -1,9 +1,21 @@
 // Package templates provides templ-based templates for the static site.
 package templates
 
+import (
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
 // DefaultFeedURL is the default RSS feed URL used when no custom URL is specified.
 const DefaultFeedURL = "/feed.xml"
 
+// DefaultJSONFeedURL is the URL of the JSON Feed generated alongside the RSS feed.
+const DefaultJSONFeedURL = "/feed.json"
+
+// DefaultAtomFeedURL is the URL of the Atom feed generated alongside the RSS feed.
+const DefaultAtomFeedURL = "/atom.xml"
+
 // DefaultOGPImageURL is the default OGP image URL.
 const DefaultOGPImageURL = "/ogp.png"
 
@@ -19,6 +31,15 @@ func ResolveFeedURL(feedURL string) string {
 	return feedURL
 }
 
+// titleOrDefault returns title if non-empty, otherwise fallback.
+// Pages use it to let a generator-provided title override their built-in one.
+func titleOrDefault(title, fallback string) string {
+	if title == "" {
+		return fallback
+	}
+	return title
+}
+
 // LayoutConfig holds configuration for the base layout template.
 type LayoutConfig struct {
 	// Title is the page title shown in the browser tab.
@@ -28,6 +49,17 @@ type LayoutConfig struct {
 	FeedURL string
 	// OGP holds Open Graph Protocol metadata for social media sharing.
 	OGP OGPConfig
+	// NoIndex asks search engines not to index the page while still
+	// following its links.
+	NoIndex bool
+	// Alternates lists the equivalent pages in other languages, emitted as
+	// <link rel="alternate" hreflang="...">.
+	Alternates []AlternateLink
+	// AuthorURL is linked with rel="author" (e.g. "/humans.txt") if non-empty.
+	AuthorURL string
+	// ExtraFeeds lists feeds specific to this page, advertised for
+	// autodiscovery in addition to the site-wide feeds.
+	ExtraFeeds []FeedLink
 }
 
 // OGPConfig holds Open Graph Protocol metadata.
@@ -60,6 +92,44 @@ type PageConfig struct {
 	FeedURL string
 	// OGP holds Open Graph Protocol metadata for social media sharing.
 	OGP OGPConfig
+	// NoIndex asks search engines not to index the page while still
+	// following its links. Used for non-canonical aggregate pages.
+	NoIndex bool
+	// Alternates lists the base URLs of the same site in other languages.
+	// Each is combined with CurrentPath to link the equivalent page.
+	Alternates []AlternateLanguage
+	// AuthorURL is linked with rel="author" (e.g. "/humans.txt") if non-empty.
+	AuthorURL string
+	// ExtraFeeds lists feeds specific to this page, advertised for
+	// autodiscovery in addition to the site-wide feeds.
+	ExtraFeeds []FeedLink
+}
+
+// AlternateLanguage declares a language version of the site rooted at BaseURL.
+type AlternateLanguage struct {
+	// Lang is the hreflang value (e.g., "en", "ja", "x-default").
+	Lang string
+	// BaseURL is the absolute base URL of that language version, without a
+	// trailing slash (e.g., "https://example.com/en").
+	BaseURL string
+}
+
+// AlternateLink is a resolved hreflang alternate of a single page.
+type AlternateLink struct {
+	Lang string
+	URL  string
+}
+
+// ResolveAlternates returns the alternate links of the page at path.
+func ResolveAlternates(alternates []AlternateLanguage, path string) []AlternateLink {
+	if len(alternates) == 0 {
+		return nil
+	}
+	links := make([]AlternateLink, len(alternates))
+	for i, alt := range alternates {
+		links[i] = AlternateLink{Lang: alt.Lang, URL: alt.BaseURL + path}
+	}
+	return links
 }
 
 // GetFeedURL returns the feed URL, using the default if not set.
@@ -83,6 +153,15 @@ func (ogp OGPConfig) GetOGPType() string {
 	return ogp.Type
 }
 
+// CanonicalURL returns URL if it is absolute, or "" if the page URL is
+// relative, e.g. because no site URL is configured.
+func (ogp OGPConfig) CanonicalURL() string {
+	if !strings.HasPrefix(ogp.URL, "https://") && !strings.HasPrefix(ogp.URL, "http://") {
+		return ""
+	}
+	return ogp.URL
+}
+
 // NewOGPConfig creates a default OGP configuration with the given parameters.
 // siteURL is the base URL of the site (e.g., "https://example.com").
 func NewOGPConfig(siteURL, path, title, description string) OGPConfig {
@@ -112,3 +191,39 @@ func NewOGPConfigWithImage(siteURL, path, imagePath, title, description string)
 		Type:        "website",
 	}
 }
+
+// OGPDescriptionMaxLength is the maximum length, in runes, of page
+// descriptions derived from proposal summaries.
+const OGPDescriptionMaxLength = 120
+
+// SummaryExcerpt returns a plain-text excerpt of a markdown summary for use in
+// meta descriptions. Headings are dropped, list and emphasis markers are
+// removed and whitespace is collapsed. Text longer than maxRunes is cut at a
+// rune boundary and ends with "…".
+func SummaryExcerpt(summary string, maxRunes int) string {
+	var words []string
+	for line := range strings.Lines(summary) {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimLeft(line, "-*+> ")
+		line = strings.NewReplacer("**", "", "__", "", "`", "").Replace(line)
+		words = append(words, strings.Fields(line)...)
+	}
+	excerpt := strings.Join(words, " ")
+
+	runes := []rune(excerpt)
+	if maxRunes < 1 || len(runes) <= maxRunes {
+		return excerpt
+	}
+	return strings.TrimSpace(string(runes[:maxRunes-1])) + "…"
+}
+
+// langAttrs returns a lang attribute for lang, or no attributes if empty.
+func langAttrs(lang string) templ.Attributes {
+	if lang == "" {
+		return templ.Attributes{}
+	}
+	return templ.Attributes{"lang": lang}
+}
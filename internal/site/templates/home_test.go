@@ -389,7 +389,7 @@ func TestConvertToHomeData(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			data := templates.ConvertToHomeData(tt.weeks)
+			data := templates.ConvertToHomeData(tt.weeks, "")
 
 			if len(data.Weeks) != tt.wantWeeks {
 				t.Fatalf("expected %d weeks, got %d", tt.wantWeeks, len(data.Weeks))
@@ -416,3 +416,49 @@ func TestPageWithLayoutConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestSummaryExcerpt(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		summary  string
+		want     string
+		maxRunes int
+	}{
+		{
+			name:     "empty summary",
+			summary:  "",
+			maxRunes: 10,
+			want:     "",
+		},
+		{
+			name:     "drops headings and markdown markers",
+			summary:  "## 概要\n\n**ジェネリクス**の`constraints`を追加する提案。\n\n- 互換性あり\n",
+			maxRunes: 100,
+			want:     "ジェネリクスのconstraintsを追加する提案。 互換性あり",
+		},
+		{
+			name:     "truncates at rune boundary with ellipsis",
+			summary:  "あいうえおかきくけこ",
+			maxRunes: 5,
+			want:     "あいうえ…",
+		},
+		{
+			name:     "exactly at limit is unchanged",
+			summary:  "あいうえお",
+			maxRunes: 5,
+			want:     "あいうえお",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := templates.SummaryExcerpt(tt.summary, tt.maxRunes); got != tt.want {
+				t.Errorf("SummaryExcerpt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
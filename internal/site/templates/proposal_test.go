@@ -3,6 +3,7 @@ package templates_test
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -39,6 +40,33 @@ func TestProposalDetail(t *testing.T) {
 				"https://github.com/golang/go/issues/12345",
 			},
 		},
+		{
+			name: "links the minutes reviewer",
+			proposal: templates.ProposalDetailData{
+				IssueNumber:   12345,
+				Title:         "test",
+				CurrentStatus: parser.StatusAccepted,
+				IssueURL:      "https://github.com/golang/go/issues/12345",
+				Reviewer:      "rsc",
+			},
+			wantContains: []string{
+				"proposal-reviewer",
+				"https://github.com/rsc",
+				"@rsc",
+			},
+		},
+		{
+			name: "omits reviewer when unknown",
+			proposal: templates.ProposalDetailData{
+				IssueNumber:   12345,
+				Title:         "test",
+				CurrentStatus: parser.StatusAccepted,
+				IssueURL:      "https://github.com/golang/go/issues/12345",
+			},
+			wantNotContain: []string{
+				"proposal-reviewer",
+			},
+		},
 		{
 			name: "displays status badge",
 			proposal: templates.ProposalDetailData{
@@ -257,6 +285,267 @@ func TestProposalDetail(t *testing.T) {
 	}
 }
 
+func TestProposalDetail_SummaryLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		lang     string
+		wantAttr bool
+	}{
+		{name: "marks summary language", lang: "en", wantAttr: true},
+		{name: "omits lang when unknown", lang: "", wantAttr: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := templates.ProposalDetailData{
+				IssueNumber:     12345,
+				Title:           "proposal: localized",
+				CurrentStatus:   parser.StatusActive,
+				Summary:         "English summary",
+				FullContent:     "English summary",
+				IssueURL:        "https://github.com/golang/go/issues/12345",
+				SummaryLanguage: tt.lang,
+			}
+
+			var buf bytes.Buffer
+			if err := templates.ProposalDetail(data).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if got := strings.Contains(buf.String(), `lang="en"`); got != tt.wantAttr {
+				t.Errorf("proposal page has lang=\"en\" = %v, want %v", got, tt.wantAttr)
+			}
+
+			buf.Reset()
+			item := templates.ProposalData{
+				IssueNumber:     12345,
+				Title:           "proposal: localized",
+				CurrentStatus:   parser.StatusActive,
+				Summary:         "English summary",
+				SummaryLanguage: tt.lang,
+			}
+			if err := templates.ProposalListItem(item).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			if got := strings.Contains(buf.String(), `lang="en"`); got != tt.wantAttr {
+				t.Errorf("list item has lang=\"en\" = %v, want %v", got, tt.wantAttr)
+			}
+		})
+	}
+}
+
+func TestProposalDetail_SummaryEN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		summaryEN  string
+		wantToggle bool
+	}{
+		{
+			name:       "renders both summaries with a toggle",
+			summaryEN:  "An English summary.",
+			wantToggle: true,
+		},
+		{
+			name:       "renders only Japanese without translation",
+			wantToggle: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := templates.ProposalDetailData{
+				IssueNumber:   12345,
+				Title:         "proposal: bilingual",
+				CurrentStatus: parser.StatusActive,
+				Summary:       "日本語の要約です。",
+				FullContent:   "## 概要\n日本語の要約です。",
+				SummaryEN:     tt.summaryEN,
+			}
+
+			var buf bytes.Buffer
+			if err := templates.ProposalDetail(data).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html := buf.String()
+			if !strings.Contains(html, "日本語の要約です。") {
+				t.Error("Japanese summary should always be rendered")
+			}
+			if got := strings.Contains(html, "summary-lang-toggle"); got != tt.wantToggle {
+				t.Errorf("has toggle = %v, want %v", got, tt.wantToggle)
+			}
+			if tt.wantToggle {
+				if !strings.Contains(html, tt.summaryEN) {
+					t.Error("English summary should be rendered")
+				}
+				if !strings.Contains(html, `lang="en"`) {
+					t.Error("English summary should be marked with lang=\"en\"")
+				}
+			}
+		})
+	}
+}
+
+func TestProposalDetail_History(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 1, 26, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		history      []templates.StatusHistoryData
+		wantTimeline bool
+	}{
+		{
+			name: "renders timeline for several statuses",
+			history: []templates.StatusHistoryData{
+				{Status: parser.StatusDiscussions, ChangedAt: day},
+				{Status: parser.StatusLikelyAccept, ChangedAt: day.AddDate(0, 0, 1)},
+				{Status: parser.StatusAccepted, ChangedAt: day.AddDate(0, 0, 2)},
+			},
+			wantTimeline: true,
+		},
+		{
+			name:         "omits timeline without history",
+			wantTimeline: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := templates.ProposalDetailData{
+				IssueNumber:   12345,
+				Title:         "proposal: history",
+				CurrentStatus: parser.StatusAccepted,
+				IssueURL:      "https://github.com/golang/go/issues/12345",
+				History:       tt.history,
+			}
+
+			var buf bytes.Buffer
+			if err := templates.ProposalDetail(data).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html := buf.String()
+			if got := strings.Contains(html, "status-timeline"); got != tt.wantTimeline {
+				t.Fatalf("has timeline = %v, want %v", got, tt.wantTimeline)
+			}
+			if !tt.wantTimeline {
+				return
+			}
+
+			// Statuses appear oldest first with their dates
+			last := -1
+			for _, h := range tt.history {
+				i := strings.Index(html, `datetime="`+h.ChangedAt.Format(time.RFC3339)+`"`)
+				if i < 0 {
+					t.Fatalf("timeline should contain %s at %s", h.Status, h.ChangedAt.Format(time.RFC3339))
+				}
+				if i < last {
+					t.Errorf("%s should come after the previous status", h.Status)
+				}
+				last = i
+			}
+		})
+	}
+}
+
+func TestProposalDetail_MaxLinks(t *testing.T) {
+	t.Parallel()
+
+	links := []templates.LinkData{
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/1"},
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/2"},
+		{Title: "design doc", URL: "https://go.googlesource.com/proposal/+/master/design/12345-x.md"},
+		{Title: "related discussion", URL: "https://github.com/golang/go/issues/3"},
+		{Title: "proposal issue", URL: "https://github.com/golang/go/issues/12345"},
+	}
+
+	tests := []struct {
+		name       string
+		wantShown  []string
+		wantHidden []string
+		maxLinks   int
+	}{
+		{
+			name: "shows all links without a cap",
+			wantShown: []string{
+				"https://github.com/golang/go/issues/1",
+				"https://github.com/golang/go/issues/2",
+				"https://go.googlesource.com/proposal/+/master/design/12345-x.md",
+				"https://github.com/golang/go/issues/3",
+			},
+		},
+		{
+			name:     "collapses links beyond the cap preferring issue and design links",
+			maxLinks: 3,
+			wantShown: []string{
+				"https://go.googlesource.com/proposal/+/master/design/12345-x.md",
+				"https://github.com/golang/go/issues/1",
+			},
+			wantHidden: []string{
+				"https://github.com/golang/go/issues/2",
+				"https://github.com/golang/go/issues/3",
+			},
+		},
+		{
+			name:     "no toggle when within the cap",
+			maxLinks: 5,
+			wantShown: []string{
+				"https://github.com/golang/go/issues/1",
+				"https://github.com/golang/go/issues/3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			err := templates.ProposalDetail(templates.ProposalDetailData{
+				IssueNumber:   12345,
+				Title:         "proposal: many links",
+				CurrentStatus: parser.StatusActive,
+				IssueURL:      "https://github.com/golang/go/issues/12345",
+				Links:         links,
+				MaxLinks:      tt.maxLinks,
+			}).Render(context.Background(), &buf)
+			if err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+
+			html := buf.String()
+			shown, hidden, collapsed := strings.Cut(html, `<details class="more-links">`)
+			if collapsed != (len(tt.wantHidden) > 0) {
+				t.Fatalf("collapsed section present = %v, want %v", collapsed, len(tt.wantHidden) > 0)
+			}
+			for _, url := range tt.wantShown {
+				if !strings.Contains(shown, url) {
+					t.Errorf("expected %q to be shown before the toggle", url)
+				}
+			}
+			for _, url := range tt.wantHidden {
+				if strings.Contains(shown, url) {
+					t.Errorf("expected %q not to be shown before the toggle", url)
+				}
+				if !strings.Contains(hidden, url) {
+					t.Errorf("expected %q inside the collapsed section", url)
+				}
+			}
+			if collapsed && !strings.Contains(hidden, fmt.Sprintf("他%d件のリンクを表示", len(tt.wantHidden))) {
+				t.Errorf("expected show-more label for %d links", len(tt.wantHidden))
+			}
+		})
+	}
+}
+
 func TestProposalDetailPage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,126 @@
+package templates_test
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mazrean/go-proposal-review-meeting/internal/content"
+	"github.com/mazrean/go-proposal-review-meeting/internal/parser"
+	"github.com/mazrean/go-proposal-review-meeting/internal/site/templates"
+)
+
+// statsFixture is a fixed dataset whose counts are verified by hand:
+//
+//	2026-W05: accepted x2, declined x1, hold x1
+//	2026-W06: accepted x1, likely_accept x1
+//	2025-W52: declined x1, hold x2, active x1
+//
+// Totals: accepted 3, declined 2, likely_accept 1, active 1, hold 3 (10 updates).
+func statsFixture() []*content.WeeklyContent {
+	changedAt := time.Date(2026, 1, 28, 12, 0, 0, 0, time.UTC)
+	week := func(year, w int, statuses ...parser.Status) *content.WeeklyContent {
+		wc := &content.WeeklyContent{Year: year, Week: w}
+		for i, status := range statuses {
+			wc.Proposals = append(wc.Proposals, content.ProposalContent{
+				IssueNumber:   year*1000 + w*10 + i,
+				Title:         "proposal: stats",
+				CurrentStatus: status,
+				ChangedAt:     changedAt,
+			})
+		}
+		return wc
+	}
+	return []*content.WeeklyContent{
+		week(2026, 5, parser.StatusAccepted, parser.StatusDeclined, parser.StatusHold, parser.StatusAccepted),
+		nil,
+		week(2025, 52, parser.StatusHold, parser.StatusDeclined, parser.StatusActive, parser.StatusHold),
+		week(2026, 6, parser.StatusLikelyAccept, parser.StatusAccepted),
+	}
+}
+
+func TestConvertToStatsData(t *testing.T) {
+	t.Parallel()
+
+	data := templates.ConvertToStatsData(statsFixture(), "https://example.com")
+
+	wantStatuses := []parser.Status{
+		parser.StatusAccepted, parser.StatusDeclined, parser.StatusLikelyAccept, parser.StatusActive, parser.StatusHold,
+	}
+	if !slices.Equal(data.Statuses, wantStatuses) {
+		t.Fatalf("Statuses = %v, want %v", data.Statuses, wantStatuses)
+	}
+	if want := []int{3, 2, 1, 1, 3}; !slices.Equal(data.Totals, want) {
+		t.Errorf("Totals = %v, want %v", data.Totals, want)
+	}
+	if data.Total != 10 {
+		t.Errorf("Total = %d, want 10", data.Total)
+	}
+
+	wantWeeks := []struct {
+		url    string
+		counts []int
+		total  int
+	}{
+		{url: "/2026/w06/", counts: []int{1, 0, 1, 0, 0}, total: 2},
+		{url: "/2026/w05/", counts: []int{2, 1, 0, 0, 1}, total: 4},
+		{url: "/2025/w52/", counts: []int{0, 1, 0, 1, 2}, total: 4},
+	}
+	if len(data.Weeks) != len(wantWeeks) {
+		t.Fatalf("got %d weeks, want %d", len(data.Weeks), len(wantWeeks))
+	}
+	for i, want := range wantWeeks {
+		got := data.Weeks[i]
+		if got.URL != want.url || !slices.Equal(got.Counts, want.counts) || got.Total != want.total {
+			t.Errorf("Weeks[%d] = {URL: %s, Counts: %v, Total: %d}, want {URL: %s, Counts: %v, Total: %d}",
+				i, got.URL, got.Counts, got.Total, want.url, want.counts, want.total)
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		weeks    []*content.WeeklyContent
+		contains []string
+	}{
+		{
+			name:  "renders totals and per-week rows",
+			weeks: statsFixture(),
+			contains: []string{
+				"3週で10件のProposal更新",
+				`<dd class="stats-total-accepted text-2xl font-bold text-[var(--text-primary)]">3件</dd>`,
+				`<dd class="stats-total-hold text-2xl font-bold text-[var(--text-primary)]">3件</dd>`,
+				`href="/2025/w52/"`,
+				"2026-W06",
+			},
+		},
+		{
+			name:     "empty data shows a placeholder",
+			weeks:    nil,
+			contains: []string{"まだ週次まとめがありません"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			if err := templates.Stats(templates.ConvertToStatsData(tt.weeks, "")).Render(context.Background(), &buf); err != nil {
+				t.Fatalf("failed to render: %v", err)
+			}
+			html := buf.String()
+			for _, want := range tt.contains {
+				if !strings.Contains(html, want) {
+					t.Errorf("expected HTML to contain %q", want)
+				}
+			}
+		})
+	}
+}
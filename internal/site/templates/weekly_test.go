@@ -309,6 +309,25 @@ func TestProposalListItem(t *testing.T) {
 				`href=""`,
 			},
 		},
+		{
+			name: "marks change made this week",
+			proposal: templates.ProposalData{
+				IssueNumber:     12345,
+				Title:           "test",
+				CurrentStatus:   parser.StatusAccepted,
+				ChangedThisWeek: true,
+			},
+			wantContains: []string{"changed-this-week", "今週の変更"},
+		},
+		{
+			name: "does not mark carried-over proposal",
+			proposal: templates.ProposalData{
+				IssueNumber:   12345,
+				Title:         "test",
+				CurrentStatus: parser.StatusAccepted,
+			},
+			wantNotContain: []string{"changed-this-week", "今週の変更"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,48 @@
+package site
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// TitleData is the data available to page title templates.
+// Fields that do not apply to a page type are left at their zero value
+// (e.g., IssueNumber on weekly pages).
+type TitleData struct {
+	// SiteTitle is the site name configured with WithGeneratorSiteTitle.
+	SiteTitle string
+	// Title is the proposal title (proposal pages only).
+	Title string
+	// Year is the ISO year of the page's week.
+	Year int
+	// Week is the ISO week number of the page's week.
+	Week int
+	// IssueNumber is the proposal issue number (proposal pages only).
+	IssueNumber int
+	// ProposalCount is the number of proposals in the week (feed items only).
+	ProposalCount int
+	// StatusBreakdown summarizes the week's proposals by status, such as
+	// "3 accepted, 1 declined" (feed items only).
+	StatusBreakdown string
+}
+
+// renderTitle renders a page title template with the given data.
+// An empty template yields an empty title so that the page keeps its default.
+func (g *Generator) renderTitle(name, text string, data TitleData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	data.SiteTitle = g.siteTitle
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s title template: %w", name, err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to execute %s title template: %w", name, err)
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
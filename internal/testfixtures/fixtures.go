@@ -113,6 +113,10 @@ func statusToMinutesFormat(s parser.Status) string {
 		return "put on hold"
 	case parser.StatusDiscussions:
 		return "discussion ongoing"
+	case parser.StatusRetracted:
+		return "retracted**"
+	case parser.StatusRemoved:
+		return "removed**"
 	default:
 		return string(s)
 	}
@@ -147,6 +151,10 @@ func statusToJapanese(s parser.Status) string {
 		return "保留"
 	case parser.StatusDiscussions:
 		return "議論中"
+	case parser.StatusRetracted:
+		return "撤回"
+	case parser.StatusRemoved:
+		return "審査対象外"
 	default:
 		return string(s)
 	}
@@ -341,6 +349,6 @@ func SetupSiteTest(t TestingT) *SiteTestSetup {
 func PrepareWeeklyContent(changes []parser.ProposalChange) *content.WeeklyContent {
 	mgr := content.NewManager()
 	weeklyContent := mgr.PrepareContent(changes)
-	_ = mgr.ApplyFallback(weeklyContent)
+	_, _ = mgr.ApplyFallback(weeklyContent)
 	return weeklyContent
 }